@@ -1,43 +1,106 @@
 package main
 
 import (
+	"adventofcode2021/pkg/fileparser"
+	"adventofcode2021/pkg/population"
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"strconv"
 	"strings"
 )
 
-const nDAYS = 80
+// oltre questo numero di giorni il risultato esatto ha troppe cifre, si stampa il modulo
+const maxGiorniEsatti = 1_000_000
+const MODULO = 1_000_000_007
 
 func main() {
-	var temp int
-	pesci := map[int]int{8: 0, 7: 0, 6: 0, 5: 0, 4: 0, 3: 0, 2: 0, 1: 0, 0: 0}
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		line := scanner.Text() //Gestione degli input
-		inputSeparated := strings.Split(line, ",")
-		for _, v := range inputSeparated {
-			temp, err := strconv.Atoi(v)
-			if err != nil {
-				fmt.Println("Input errato")
-				return
-			}
-			pesci[temp]++
+	defaults := population.DefaultConfig()
+	giorni := flag.Uint64("days", 80, "numero di giorni da simulare")
+	reset := flag.Int("reset", defaults.ResetTimer, "timer di un pesce dopo aver generato un nuovo pesce")
+	newborn := flag.Int("newborn", defaults.NewbornTimer, "timer di un pesce appena nato")
+	input := flag.String("input", "", "file di input, se vuoto si legge da stdin")
+	trace := flag.String("trace", "", "stampa la popolazione di ogni giorno: csv o json")
+	mod := flag.Int64("mod", 0, "se maggiore di 0 stampa il risultato modulo questo numero")
+	flag.Parse()
+
+	pesci, err := leggiPesci(*input)
+	if err != nil {
+		errore(err)
+	}
+	config := population.Config{ResetTimer: *reset, NewbornTimer: *newborn}
+	sim, err := population.NewSimulator(config, pesci)
+	if err != nil {
+		errore(err)
+	}
+
+	switch *trace {
+	case "": //senza traccia si usa la potenza della matrice, in O(log giorni)
+		switch {
+		case *mod > 0:
+			fmt.Println(sim.TotalAfterMod(*giorni, *mod))
+		case *giorni <= maxGiorniEsatti:
+			fmt.Println(sim.TotalAfter(*giorni))
+		default:
+			fmt.Printf("%d (mod %d)\n", sim.TotalAfterMod(*giorni, MODULO), MODULO)
 		}
+	case "csv":
+		simulaGiorni(sim, *giorni, population.NewCSVTrace(os.Stdout))
+	case "json":
+		simulaGiorni(sim, *giorni, population.NewJSONTrace(os.Stdout))
+	default:
+		errore(fmt.Errorf("formato della traccia sconosciuto %q", *trace))
+	}
+}
+
+// leggiPesci legge i timer separati da virgole dal file, o da stdin se il nome è vuoto
+func leggiPesci(filename string) ([]int, error) {
+	if filename == "" {
+		return leggiPesciDa(os.Stdin)
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
 	}
+	defer file.Close()
+	return leggiPesciDa(file)
+}
 
-	for i := 0; i < nDAYS; i++ { //esegue le operazioni successive tante quanti sono i giorni
-		temp = pesci[0]
-		for j := 8; j >= 0; j-- { //riduce il timer dei pesci
-			pesci[j], temp = temp, pesci[j]
+// leggiPesciDa legge i timer separati da virgole da ogni riga non vuota, come faceva la versione originale
+func leggiPesciDa(r io.Reader) (pesci []int, err error) {
+	defer func() { //fileparser segnala gli errori con panic
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("input errato: %v", rec)
+		}
+	}()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			pesci = append(pesci, fileparser.SplitTrim[int](line, ",")...)
 		}
-		pesci[6] += temp
 	}
-	count := 0
-	for i := 0; i < 9; i++ {
-		count += pesci[i]
+	if err := scanner.Err(); err != nil {
+		return nil, err
 	}
-	fmt.Println(count)
+	if len(pesci) == 0 {
+		return nil, fmt.Errorf("input vuoto")
+	}
+	return pesci, nil
+}
+
+// simulaGiorni esegue la simulazione giorno per giorno stampando la traccia
+func simulaGiorni(sim *population.Simulator, giorni uint64, trace func(*population.Simulator) error) {
+	if giorni > maxGiorniEsatti {
+		errore(fmt.Errorf("la traccia supporta al massimo %d giorni", maxGiorniEsatti))
+	}
+	if err := sim.Run(int(giorni), trace); err != nil {
+		errore(err)
+	}
+}
 
+func errore(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
 }
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"adventofcode2021/pkg/population"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLeggiPesciDa(t *testing.T) {
+	cases := map[string][]int{
+		"3,4,3,1,2\n":    {3, 4, 3, 1, 2},
+		"3,4\n3,1\n":     {3, 4, 3, 1},
+		"3, 4,3\n\n 1 ":  {3, 4, 3, 1},
+		"3,4,3,1,2":      {3, 4, 3, 1, 2},
+		"\n\n0,8\n\n6\n": {0, 8, 6},
+	}
+	for input, want := range cases {
+		got, err := leggiPesciDa(strings.NewReader(input))
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("leggiPesciDa(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "\n\n", "3,x", "3;4"} {
+		if got, err := leggiPesciDa(strings.NewReader(input)); err == nil {
+			t.Errorf("leggiPesciDa(%q) = %v, want an error", input, got)
+		}
+	}
+}
+
+func TestEsempio(t *testing.T) {
+	pesci, err := leggiPesciDa(strings.NewReader("3,4,3,1,2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim, err := population.NewSimulator(population.DefaultConfig(), pesci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	risposte := map[uint64]int64{18: 26, 80: 5934, 256: 26984457539}
+	for giorni, attesi := range risposte {
+		if got := sim.TotalAfter(giorni); !got.IsInt64() || got.Int64() != attesi {
+			t.Errorf("TotalAfter(%d) = %v, want %d", giorni, got, attesi)
+		}
+		if got := sim.TotalAfterMod(giorni, MODULO); got != attesi%MODULO {
+			t.Errorf("TotalAfterMod(%d) = %d, want %d", giorni, got, attesi%MODULO)
+		}
+	}
+	//la simulazione giorno per giorno deve dare lo stesso risultato della potenza della matrice
+	if err := sim.Run(80, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := sim.Total(); got.Int64() != 5934 {
+		t.Errorf("Total() after Run(80) = %v, want 5934", got)
+	}
+}
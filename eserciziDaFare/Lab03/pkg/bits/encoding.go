@@ -0,0 +1,55 @@
+package bits
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+// ErrInvalidBinary is returned when decoding binary data that was not produced by MarshalBinary
+var ErrInvalidBinary = errors.New("invalid bit field binary data")
+
+// ErrTooLong is returned when decoding text with more bits than a bit field can hold
+var ErrTooLong = errors.New("bit field longer than 64 bits")
+
+// MarshalText encodes the bit field as its string of 0s and 1s, this is also used for JSON
+func (b BitField) MarshalText() ([]byte, error) {
+	return []byte(NewBitFieldForVal(b.Value, b.Length).String()), nil
+}
+
+// UnmarshalText decodes a string of at most 64 0s and 1s into the bit field
+func (b *BitField) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = BitField{}
+		return nil
+	}
+	if len(text) > 64 {
+		return ErrTooLong
+	}
+	val, err := strconv.ParseUint(string(text), 2, 64)
+	if err != nil {
+		return err
+	}
+	*b = BitField{Value: val, Length: len(text), str: string(text)}
+	return nil
+}
+
+// MarshalBinary encodes the length as a uvarint followed by the 8 byte big endian value
+func (b BitField) MarshalBinary() ([]byte, error) {
+	out := binary.AppendUvarint(nil, uint64(b.Length))
+	return binary.BigEndian.AppendUint64(out, b.Value), nil
+}
+
+// UnmarshalBinary decodes a bit field produced by MarshalBinary, rejecting values with bits set above the length
+func (b *BitField) UnmarshalBinary(data []byte) error {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || len(data) != n+8 || length > 64 {
+		return ErrInvalidBinary
+	}
+	value := binary.BigEndian.Uint64(data[n:])
+	if length < 64 && value>>length != 0 {
+		return ErrInvalidBinary
+	}
+	*b = NewBitFieldForVal(value, int(length))
+	return nil
+}
@@ -0,0 +1,104 @@
+package bits
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBitFieldJSONRoundTrip(t *testing.T) {
+	for _, bin := range []string{"0", "10110", "00101", strings.Repeat("1", 64)} {
+		b := NewBitField(bin)
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded BitField
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != b {
+			t.Errorf("JSON round trip of %s = %+v", bin, decoded)
+		}
+	}
+}
+
+func TestBitFieldBinaryRoundTrip(t *testing.T) {
+	for _, bin := range []string{"1", "00101", strings.Repeat("10", 32)} {
+		b := NewBitField(bin)
+		data, err := b.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded BitField
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != b {
+			t.Errorf("binary round trip of %s = %+v", bin, decoded)
+		}
+	}
+}
+
+func TestBitFieldArrayRoundTrip(t *testing.T) {
+	array := BitFieldArray{NewBitField("00100"), NewBitField("11110"), NewBitField("10110")}
+
+	data, err := json.Marshal(array)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `["00100","11110","10110"]` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+	var fromJSON BitFieldArray
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromJSON, array) {
+		t.Errorf("JSON round trip = %v", fromJSON)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(array); err != nil {
+		t.Fatal(err)
+	}
+	var fromGob BitFieldArray
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromGob, array) {
+		t.Errorf("gob round trip = %v", fromGob)
+	}
+}
+
+func TestBitFieldDecodeErrors(t *testing.T) {
+	var b BitField
+	if err := b.UnmarshalText([]byte(strings.Repeat("0", 65))); !errors.Is(err, ErrTooLong) {
+		t.Errorf("UnmarshalText() of 65 bits = %v, want ErrTooLong", err)
+	}
+	if err := b.UnmarshalText([]byte("102")); err == nil {
+		t.Error("UnmarshalText() accepted a non binary digit")
+	}
+	valid, _ := NewBitField("101").MarshalBinary()
+	full, _ := NewBitField(strings.Repeat("1", 64)).MarshalBinary()
+	if err := b.UnmarshalBinary(full); err != nil || b.Value != math.MaxUint64 {
+		t.Errorf("UnmarshalBinary() of 64 set bits = %v, %v", b, err)
+	}
+	for name, data := range map[string][]byte{
+		"empty":     nil,
+		"truncated": valid[:len(valid)-1],
+		"trailing":  append(append([]byte{}, valid...), 0),
+		"too long":  append([]byte{65}, valid[1:]...),
+		"high bits": {3, 0, 0, 0, 0, 0, 0, 0, 0xFF},
+		"no length": {0, 0, 0, 0, 0, 0, 0, 0, 1},
+	} {
+		if err := b.UnmarshalBinary(data); !errors.Is(err, ErrInvalidBinary) {
+			t.Errorf("UnmarshalBinary(%s) = %v, want ErrInvalidBinary", name, err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package cache
+
+import "adventofcode2021/pkg/maps"
+
+// LFU is a fixed capacity cache that evicts the least frequently used entry when full,
+// choosing the least recently used among entries with the same frequency
+type LFU[K comparable, V any] struct {
+	capacity int
+	entries  map[K]*lfuEntry[V]
+	buckets  map[int]*maps.OrderedMap[K, struct{}] // keys by use count, least recently used at the front
+	minCount int
+	onEvict  func(key K, value V)
+}
+
+type lfuEntry[V any] struct {
+	value V
+	count int
+}
+
+// NewLFU creates an empty cache holding at most capacity entries. onEvict, if not nil, is called
+// with each entry removed to make room for another
+func NewLFU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LFU[K, V] {
+	checkCapacity(capacity)
+	return &LFU[K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*lfuEntry[V]),
+		buckets:  make(map[int]*maps.OrderedMap[K, struct{}]),
+		onEvict:  onEvict,
+	}
+}
+
+func (c *LFU[K, V]) bucket(count int) *maps.OrderedMap[K, struct{}] {
+	return maps.GetOrInsert(c.buckets, count, maps.NewOrderedMap[K, struct{}])
+}
+
+func (c *LFU[K, V]) removeFromBucket(key K, count int) {
+	b := c.buckets[count]
+	b.Delete(key)
+	if b.Len() == 0 {
+		delete(c.buckets, count)
+		if c.minCount == count {
+			c.minCount++
+		}
+	}
+}
+
+// touch moves the key into the bucket for one more use
+func (c *LFU[K, V]) touch(key K, e *lfuEntry[V]) {
+	c.removeFromBucket(key, e.count)
+	e.count++
+	c.bucket(e.count).Set(key, struct{}{})
+}
+
+// Get will return the cached value for key and count the use
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		var blank V
+		return blank, false
+	}
+	c.touch(key, e)
+	return e.value, true
+}
+
+// Peek will return the cached value for key without counting the use
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.entries[key]; ok {
+		return e.value, true
+	}
+	var blank V
+	return blank, false
+}
+
+// Put will cache the value for key and count the use, evicting the least frequently used entry
+// if the cache is full
+func (c *LFU[K, V]) Put(key K, value V) {
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		c.touch(key, e)
+		return
+	}
+	if len(c.entries) == c.capacity {
+		k, _, _ := c.buckets[c.minCount].Front()
+		evicted := c.entries[k]
+		c.removeFromBucket(k, evicted.count)
+		delete(c.entries, k)
+		if c.onEvict != nil {
+			c.onEvict(k, evicted.value)
+		}
+	}
+	c.entries[key] = &lfuEntry[V]{value: value, count: 1}
+	c.bucket(1).Set(key, struct{}{})
+	c.minCount = 1
+}
+
+// Remove will remove the key from the cache without calling the eviction callback
+func (c *LFU[K, V]) Remove(key K) bool {
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	delete(c.entries, key)
+	c.buckets[e.count].Delete(key)
+	if c.buckets[e.count].Len() == 0 {
+		delete(c.buckets, e.count)
+		if e.count == c.minCount {
+			c.resetMinCount()
+		}
+	}
+	return true
+}
+
+// resetMinCount finds the smallest use count after the minimum bucket was removed outside of touch
+func (c *LFU[K, V]) resetMinCount() {
+	c.minCount = 0
+	for count := range c.buckets {
+		if c.minCount == 0 || count < c.minCount {
+			c.minCount = count
+		}
+	}
+}
+
+// Len returns the number of cached entries
+func (c *LFU[K, V]) Len() int {
+	return len(c.entries)
+}
+
+// Capacity returns the maximum number of cached entries
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
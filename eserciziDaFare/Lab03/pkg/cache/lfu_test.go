@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestLFUFrequencyTies(t *testing.T) {
+	var evicted []int
+	c := NewLFU(3, func(key int, value string) {
+		evicted = append(evicted, key)
+	})
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(3, "c")
+	c.Get(1)
+	c.Get(2)
+	c.Put(4, "d") //3 is the only key used once
+	c.Get(4)      //1, 2 and 4 are all used twice, 1 least recently
+	c.Put(5, "e")
+	c.Peek(5) //peeking does not count, so 5 stays at one use
+	c.Put(6, "f")
+	if !reflect.DeepEqual(evicted, []int{3, 1, 5}) {
+		t.Errorf("evicted %v, want [3 1 5]", evicted)
+	}
+	if v, ok := c.Get(2); !ok || v != "b" {
+		t.Errorf("Get(2) = %q, %v", v, ok)
+	}
+}
+
+func TestLFURemoveThenPut(t *testing.T) {
+	c := NewLFU[string, int](2, nil)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("b")
+	c.Get("b")
+	c.Remove("a") //the bucket for two uses empties, the minimum moves up to b's three uses
+	if c.minCount != 3 {
+		t.Errorf("minCount after Remove = %d, want 3", c.minCount)
+	}
+	c.Put("c", 3)
+	if c.minCount != 1 {
+		t.Errorf("minCount after Put = %d, want 1", c.minCount)
+	}
+	c.Put("d", 4) //c is the least frequently used
+	if _, ok := c.Peek("c"); ok {
+		t.Error("c was not evicted")
+	}
+	if _, ok := c.Peek("b"); !ok {
+		t.Error("b was evicted despite more uses")
+	}
+	c.Remove("b")
+	c.Remove("d")
+	if c.Len() != 0 || len(c.buckets) != 0 {
+		t.Errorf("Len() = %d with %d buckets after removing everything", c.Len(), len(c.buckets))
+	}
+	c.Put("e", 5)
+	if v, ok := c.Get("e"); !ok || v != 5 || c.minCount != 2 {
+		t.Errorf("Get(e) = %d, %v with minCount %d", v, ok, c.minCount)
+	}
+}
+
+func TestLFUMatchesModel(t *testing.T) {
+	type modelEntry struct{ value, count, lastUse int }
+	rng := rand.New(rand.NewPCG(29, 30))
+	c := NewLFU[int, int](5, nil)
+	model := make(map[int]*modelEntry)
+	for clock := range 5000 {
+		key := rng.IntN(12)
+		switch rng.IntN(4) {
+		case 0:
+			_, ok := model[key]
+			if c.Remove(key) != ok {
+				t.Fatalf("step %d: Remove(%d) disagrees with the model", clock, key)
+			}
+			delete(model, key)
+		case 1:
+			e, ok := model[key]
+			got, gotOk := c.Get(key)
+			if gotOk != ok || (ok && got != e.value) {
+				t.Fatalf("step %d: Get(%d) = %d, %v", clock, key, got, gotOk)
+			}
+			if ok {
+				e.count++
+				e.lastUse = clock
+			}
+		default:
+			if e, ok := model[key]; ok {
+				e.value, e.count, e.lastUse = clock, e.count+1, clock
+			} else {
+				if len(model) == 5 {
+					victim := -1
+					for k, e := range model {
+						if victim == -1 || e.count < model[victim].count ||
+							(e.count == model[victim].count && e.lastUse < model[victim].lastUse) {
+							victim = k
+						}
+					}
+					delete(model, victim)
+				}
+				model[key] = &modelEntry{value: clock, count: 1, lastUse: clock}
+			}
+			c.Put(key, clock)
+		}
+		if c.Len() != len(model) {
+			t.Fatalf("step %d: Len() = %d, want %d", clock, c.Len(), len(model))
+		}
+	}
+	for key, e := range model {
+		if got, ok := c.Peek(key); !ok || got != e.value {
+			t.Errorf("Peek(%d) = %d, %v, want %d", key, got, ok, e.value)
+		}
+	}
+}
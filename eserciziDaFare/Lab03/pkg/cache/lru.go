@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"adventofcode2021/pkg/maps"
+	"fmt"
+)
+
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("cache capacity must be positive, got %d", capacity))
+	}
+}
+
+// LRU is a fixed capacity cache that evicts the least recently used entry when full
+type LRU[K comparable, V any] struct {
+	capacity int
+	entries  *maps.OrderedMap[K, V] // least recently used at the front
+	onEvict  func(key K, value V)
+}
+
+// NewLRU creates an empty cache holding at most capacity entries. onEvict, if not nil, is called
+// with each entry removed to make room for another
+func NewLRU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LRU[K, V] {
+	checkCapacity(capacity)
+	return &LRU[K, V]{capacity: capacity, entries: maps.NewOrderedMap[K, V](), onEvict: onEvict}
+}
+
+// Get will return the cached value for key and mark it as recently used
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	val, ok := c.entries.Get(key)
+	if ok {
+		c.entries.MoveToBack(key)
+	}
+	return val, ok
+}
+
+// Peek will return the cached value for key without marking it as recently used
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	return c.entries.Get(key)
+}
+
+// Put will cache the value for key and mark it as recently used, evicting the least recently used
+// entry if the cache is full
+func (c *LRU[K, V]) Put(key K, value V) {
+	if !c.entries.ContainsKey(key) && c.entries.Len() == c.capacity {
+		k, v, _ := c.entries.PopFront()
+		if c.onEvict != nil {
+			c.onEvict(k, v)
+		}
+	}
+	c.entries.Set(key, value)
+	c.entries.MoveToBack(key)
+}
+
+// Remove will remove the key from the cache without calling the eviction callback
+func (c *LRU[K, V]) Remove(key K) bool {
+	return c.entries.Delete(key)
+}
+
+// Len returns the number of cached entries
+func (c *LRU[K, V]) Len() int {
+	return c.entries.Len()
+}
+
+// Capacity returns the maximum number of cached entries
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLRUEvictionOrder(t *testing.T) {
+	var evicted []string
+	c := NewLRU(3, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")     //a is now the most recently used
+	c.Peek("b")    //peeking does not count as a use
+	c.Put("d", 4)  //evicts b
+	c.Put("c", 30) //updating is a use, so a becomes the oldest
+	c.Put("e", 5)  //evicts a
+	if !reflect.DeepEqual(evicted, []string{"b", "a"}) {
+		t.Errorf("evicted %v, want [b a]", evicted)
+	}
+	if v, ok := c.Get("c"); !ok || v != 30 {
+		t.Errorf("Get(c) = %d, %v", v, ok)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error("b still cached after eviction")
+	}
+	if c.Len() != 3 || c.Capacity() != 3 {
+		t.Errorf("Len() = %d, Capacity() = %d", c.Len(), c.Capacity())
+	}
+	if !c.Remove("d") || c.Remove("d") {
+		t.Error("Remove(d) did not remove the key exactly once")
+	}
+	c.Put("f", 6) //room was made by Remove, nothing is evicted
+	if len(evicted) != 2 {
+		t.Errorf("Remove triggered the eviction callback: %v", evicted)
+	}
+}
+
+func TestCapacityPanics(t *testing.T) {
+	for name, create := range map[string]func(){
+		"LRU": func() { NewLRU[int, int](0, nil) },
+		"LFU": func() { NewLFU[int, int](-1, nil) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s accepted a capacity below 1", name)
+				}
+			}()
+			create()
+		}()
+	}
+}
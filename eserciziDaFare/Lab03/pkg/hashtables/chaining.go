@@ -0,0 +1,96 @@
+package hashtables
+
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+}
+
+// Chaining resolves collisions by keeping a list of entries in every bucket (default load factor 1)
+type Chaining[K comparable, V any] struct {
+	config  Config[K]
+	buckets [][]entry[K, V]
+	n       int
+	instrument
+}
+
+// NewChaining creates an empty separate chaining table
+func NewChaining[K comparable, V any](config Config[K]) *Chaining[K, V] {
+	config = config.withDefaults(1)
+	return &Chaining[K, V]{config: config, buckets: make([][]entry[K, V], config.InitialCapacity)}
+}
+
+func (t *Chaining[K, V]) bucketFor(hash uint64) int {
+	return int(hash & uint64(len(t.buckets)-1))
+}
+
+// find returns the position of key within its bucket, or -1, and records the probe length
+func (t *Chaining[K, V]) find(hash uint64, key K) (int, int) {
+	b := t.bucketFor(hash)
+	for i, e := range t.buckets[b] {
+		if e.hash == hash && e.key == key {
+			t.recordProbe(i + 1)
+			return b, i
+		}
+	}
+	t.recordProbe(len(t.buckets[b]))
+	return b, -1
+}
+
+func (t *Chaining[K, V]) Put(key K, value V) {
+	hash := t.config.Hash(key)
+	b, i := t.find(hash, key)
+	if i >= 0 {
+		t.buckets[b][i].value = value
+		return
+	}
+	if len(t.buckets[b]) > 0 {
+		t.collisions++
+	}
+	t.buckets[b] = append(t.buckets[b], entry[K, V]{hash: hash, key: key, value: value})
+	t.n++
+	if t.config.overloaded(t.n, len(t.buckets)) {
+		t.resize(len(t.buckets) * t.config.GrowthFactor)
+	}
+}
+
+func (t *Chaining[K, V]) Get(key K) (V, bool) {
+	b, i := t.find(t.config.Hash(key), key)
+	if i < 0 {
+		var blank V
+		return blank, false
+	}
+	return t.buckets[b][i].value, true
+}
+
+func (t *Chaining[K, V]) Delete(key K) bool {
+	b, i := t.find(t.config.Hash(key), key)
+	if i < 0 {
+		return false
+	}
+	bucket := t.buckets[b]
+	bucket[i] = bucket[len(bucket)-1]
+	t.buckets[b] = bucket[:len(bucket)-1]
+	t.n--
+	return true
+}
+
+func (t *Chaining[K, V]) resize(capacity int) {
+	old := t.buckets
+	t.buckets = make([][]entry[K, V], capacity)
+	for _, bucket := range old {
+		for _, e := range bucket {
+			b := t.bucketFor(e.hash)
+			t.buckets[b] = append(t.buckets[b], e)
+		}
+	}
+	t.resizes++
+}
+
+func (t *Chaining[K, V]) Len() int {
+	return t.n
+}
+
+func (t *Chaining[K, V]) Stats() Stats {
+	return t.stats(t.n, len(t.buckets))
+}
@@ -0,0 +1,153 @@
+package hashtables
+
+import "fmt"
+
+// maxKicks bounds the chain of evictions for one insertion before the table is resized
+const maxKicks = 32
+
+// maxGrowths bounds the resizes made while inserting one key, a hash that still cannot
+// place every key after that many is too weak for cuckoo hashing
+const maxGrowths = 6
+
+type cuckooSlot[K comparable, V any] struct {
+	entry[K, V]
+	full bool
+}
+
+// Cuckoo keeps two tables with a different hash for each, so every key lives in one of exactly two
+// slots and lookups probe at most twice. Inserting into an occupied slot evicts its key to its other
+// slot, resizing if the evictions do not settle (default load factor 0.45 over both tables). It needs a
+// well distributed hash, keys sharing a full hash value cannot all be placed however large the tables grow.
+// Put panics when the keys still do not fit after a bounded number of resizes, the table must not be used afterwards
+type Cuckoo[K comparable, V any] struct {
+	config Config[K]
+	tables [2][]cuckooSlot[K, V]
+	n      int
+	instrument
+}
+
+// NewCuckoo creates an empty cuckoo hashing table, each of its two tables has the initial capacity
+func NewCuckoo[K comparable, V any](config Config[K]) *Cuckoo[K, V] {
+	config = config.withDefaults(0.45)
+	config.requireSpareSlot()
+	t := &Cuckoo[K, V]{config: config}
+	t.tables[0] = make([]cuckooSlot[K, V], config.InitialCapacity)
+	t.tables[1] = make([]cuckooSlot[K, V], config.InitialCapacity)
+	return t
+}
+
+func (t *Cuckoo[K, V]) indexFor(table int, hash uint64) int {
+	if table == 1 {
+		hash = mix(hash)
+	}
+	return int(hash & uint64(len(t.tables[table])-1))
+}
+
+// find returns the table and slot holding key, or -1 for the table
+func (t *Cuckoo[K, V]) find(hash uint64, key K) (int, int) {
+	for table := range t.tables {
+		index := t.indexFor(table, hash)
+		s := &t.tables[table][index]
+		if s.full && s.hash == hash && s.key == key {
+			t.recordProbe(table + 1)
+			return table, index
+		}
+	}
+	t.recordProbe(len(t.tables))
+	return -1, 0
+}
+
+func (t *Cuckoo[K, V]) Put(key K, value V) {
+	hash := t.config.Hash(key)
+	if table, index := t.find(hash, key); table >= 0 {
+		t.tables[table][index].value = value
+		return
+	}
+	if t.tables[0][t.indexFor(0, hash)].full {
+		t.collisions++
+	}
+	t.n++
+	growths := 0
+	if t.config.overloaded(t.n, 2*len(t.tables[0])) {
+		t.grow(&growths)
+	}
+	carry := cuckooSlot[K, V]{entry: entry[K, V]{hash: hash, key: key, value: value}, full: true}
+	for !t.insert(&carry) {
+		t.grow(&growths)
+	}
+}
+
+// insert places the entry, evicting keys to their other table as needed. If the evictions do not
+// settle it returns false with carry holding the entry left without a slot
+func (t *Cuckoo[K, V]) insert(carry *cuckooSlot[K, V]) bool {
+	table := 0
+	for kick := 0; kick < maxKicks; kick++ {
+		s := &t.tables[table][t.indexFor(table, carry.hash)]
+		if !s.full {
+			*s = *carry
+			return true
+		}
+		*s, *carry = *carry, *s
+		table = 1 - table
+	}
+	return false
+}
+
+func (t *Cuckoo[K, V]) Get(key K) (V, bool) {
+	table, index := t.find(t.config.Hash(key), key)
+	if table < 0 {
+		var blank V
+		return blank, false
+	}
+	return t.tables[table][index].value, true
+}
+
+func (t *Cuckoo[K, V]) Delete(key K) bool {
+	table, index := t.find(t.config.Hash(key), key)
+	if table < 0 {
+		return false
+	}
+	t.tables[table][index] = cuckooSlot[K, V]{}
+	t.n--
+	return true
+}
+
+// grow rebuilds both tables with a larger capacity, growing further if an entry still cannot be placed.
+// It panics once the resizes for the current insertion, counted in growths, reach maxGrowths
+func (t *Cuckoo[K, V]) grow(growths *int) {
+	old := t.tables
+	capacity := len(old[0])
+	for {
+		if *growths == maxGrowths {
+			t.tables = old
+			panic(fmt.Sprintf("cuckoo hashing could not place %d keys after %d resizes, the hash function does not spread them enough", t.n, maxGrowths))
+		}
+		*growths++
+		capacity *= t.config.GrowthFactor
+		t.tables[0] = make([]cuckooSlot[K, V], capacity)
+		t.tables[1] = make([]cuckooSlot[K, V], capacity)
+		t.resizes++
+		if t.reinsert(old) {
+			return
+		}
+	}
+}
+
+func (t *Cuckoo[K, V]) reinsert(old [2][]cuckooSlot[K, V]) bool {
+	for _, table := range old {
+		for _, s := range table {
+			if s.full && !t.insert(&s) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *Cuckoo[K, V]) Len() int {
+	return t.n
+}
+
+func (t *Cuckoo[K, V]) Stats() Stats {
+	return t.stats(t.n, 2*len(t.tables[0]))
+}
@@ -0,0 +1,143 @@
+package hashtables
+
+import (
+	"fmt"
+	"hash/maphash"
+)
+
+// Table is implemented by every hash table in this package so they can be compared with each other
+type Table[K comparable, V any] interface {
+	// Put will store the value for key
+	Put(key K, value V)
+	// Get will return the value for key and whether it exists
+	Get(key K) (V, bool)
+	// Delete will remove the key, returning false if it was not in the table
+	Delete(key K) bool
+	// Len returns the number of keys in the table
+	Len() int
+	// Stats returns the instrumentation gathered so far
+	Stats() Stats
+}
+
+// Config controls the hashing and resizing of a table. The zero value is valid and uses
+// defaults suitable for each implementation
+type Config[K comparable] struct {
+	// Hash maps a key to a hash value, defaults to hash/maphash with a random seed
+	Hash func(K) uint64
+	// InitialCapacity is the starting number of slots, rounded up to a power of two (default 8)
+	InitialCapacity int
+	// MaxLoadFactor is the ratio of keys (and tombstones) to slots that triggers a resize,
+	// it must be below 1 for the open addressing tables
+	MaxLoadFactor float64
+	// GrowthFactor multiplies the capacity on resize, it must be a power of two (default 2)
+	GrowthFactor int
+}
+
+func (c Config[K]) withDefaults(maxLoadFactor float64) Config[K] {
+	if c.Hash == nil {
+		seed := maphash.MakeSeed()
+		c.Hash = func(k K) uint64 { return maphash.Comparable(seed, k) }
+	}
+	if c.InitialCapacity <= 0 {
+		c.InitialCapacity = 8
+	}
+	c.InitialCapacity = nextPowerOfTwo(c.InitialCapacity)
+	if c.MaxLoadFactor <= 0 {
+		c.MaxLoadFactor = maxLoadFactor
+	}
+	if c.GrowthFactor == 0 {
+		c.GrowthFactor = 2
+	}
+	if c.GrowthFactor < 2 || c.GrowthFactor&(c.GrowthFactor-1) != 0 {
+		panic(fmt.Sprintf("growth factor must be a power of two, got %d", c.GrowthFactor))
+	}
+	return c
+}
+
+// overloaded reports if holding n entries in capacity slots exceeds the maximum load factor
+func (c Config[K]) overloaded(n, capacity int) bool {
+	return float64(n) > c.MaxLoadFactor*float64(capacity)
+}
+
+// requireSpareSlot panics unless the load factor keeps at least one slot empty, which open
+// addressing needs for unsuccessful searches to terminate
+func (c Config[K]) requireSpareSlot() {
+	if c.MaxLoadFactor >= 1 {
+		panic(fmt.Sprintf("open addressing needs a load factor below 1, got %v", c.MaxLoadFactor))
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p *= 2
+	}
+	return p
+}
+
+// mix scrambles a hash value (splitmix64 finaliser), used to derive a second independent hash
+func mix(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// Stats records how a table has behaved
+type Stats struct {
+	Len        int
+	Capacity   int // number of slots, or buckets for separate chaining
+	Collisions int // insertions of a new key whose first choice slot was already taken
+	Resizes    int
+	// ProbeLengths[n] counts the Get and Put calls that examined n slots or chain entries
+	ProbeLengths []int
+}
+
+// LoadFactor returns the ratio of keys to slots
+func (s Stats) LoadFactor() float64 {
+	if s.Capacity == 0 {
+		return 0
+	}
+	return float64(s.Len) / float64(s.Capacity)
+}
+
+// MeanProbeLength returns the average number of slots examined per Get and Put
+func (s Stats) MeanProbeLength() float64 {
+	total, count := 0, 0
+	for n, c := range s.ProbeLengths {
+		total += n * c
+		count += c
+	}
+	if count == 0 {
+		return 0
+	}
+	return float64(total) / float64(count)
+}
+
+// instrument gathers the statistics shared by every table
+type instrument struct {
+	collisions   int
+	resizes      int
+	probeLengths []int
+}
+
+func (i *instrument) recordProbe(n int) {
+	for len(i.probeLengths) <= n {
+		i.probeLengths = append(i.probeLengths, 0)
+	}
+	i.probeLengths[n]++
+}
+
+func (i *instrument) stats(n, capacity int) Stats {
+	probes := make([]int, len(i.probeLengths))
+	copy(probes, i.probeLengths)
+	return Stats{
+		Len:          n,
+		Capacity:     capacity,
+		Collisions:   i.collisions,
+		Resizes:      i.resizes,
+		ProbeLengths: probes,
+	}
+}
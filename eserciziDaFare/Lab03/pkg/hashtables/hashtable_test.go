@@ -0,0 +1,127 @@
+package hashtables
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+type tableCase struct {
+	name string
+	make func(config Config[int]) Table[int, int]
+}
+
+var tableCases = []tableCase{
+	{"Chaining", func(c Config[int]) Table[int, int] { return NewChaining[int, int](c) }},
+	{"LinearProbing", func(c Config[int]) Table[int, int] { return NewLinearProbing[int, int](c) }},
+	{"QuadraticProbing", func(c Config[int]) Table[int, int] { return NewQuadraticProbing[int, int](c) }},
+	{"DoubleHashing", func(c Config[int]) Table[int, int] { return NewDoubleHashing[int, int](c) }},
+	{"RobinHood", func(c Config[int]) Table[int, int] { return NewRobinHood[int, int](c) }},
+	{"Cuckoo", func(c Config[int]) Table[int, int] { return NewCuckoo[int, int](c) }},
+}
+
+func TestTablesMatchMap(t *testing.T) {
+	for _, tc := range tableCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(1, 2))
+			table := tc.make(Config[int]{})
+			reference := make(map[int]int)
+			for op := range 20000 {
+				key := rng.IntN(500)
+				switch rng.IntN(3) {
+				case 0, 1:
+					table.Put(key, op)
+					reference[key] = op
+				case 2:
+					_, want := reference[key]
+					if got := table.Delete(key); got != want {
+						t.Fatalf("op %d: Delete(%d) = %v, want %v", op, key, got, want)
+					}
+					delete(reference, key)
+				}
+				if table.Len() != len(reference) {
+					t.Fatalf("op %d: Len() = %d, want %d", op, table.Len(), len(reference))
+				}
+			}
+			for key := range 600 {
+				want, wantOk := reference[key]
+				if got, ok := table.Get(key); got != want || ok != wantOk {
+					t.Errorf("Get(%d) = %d, %v, want %d, %v", key, got, ok, want, wantOk)
+				}
+			}
+			stats := table.Stats()
+			if stats.Len != len(reference) || stats.LoadFactor() <= 0 || stats.MeanProbeLength() <= 0 {
+				t.Errorf("unexpected stats %+v", stats)
+			}
+		})
+	}
+}
+
+func TestCuckooWeakHashPanics(t *testing.T) {
+	table := NewCuckoo[int, int](Config[int]{Hash: func(k int) uint64 { return uint64(k % 4) }})
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected a panic for a hash with only 4 values")
+		}
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, "hash function") {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	for key := range 20 {
+		table.Put(key, key)
+	}
+}
+
+var benchmarkSizes = []int{1 << 10, 1 << 16}
+
+func BenchmarkPut(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		for _, tc := range tableCases {
+			b.Run(fmt.Sprintf("%s/%d", tc.name, size), func(b *testing.B) {
+				for range b.N {
+					table := tc.make(Config[int]{})
+					for key := range size {
+						table.Put(key, key)
+					}
+				}
+			})
+		}
+		b.Run(fmt.Sprintf("map/%d", size), func(b *testing.B) {
+			for range b.N {
+				table := make(map[int]int)
+				for key := range size {
+					table[key] = key
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		for _, tc := range tableCases {
+			b.Run(fmt.Sprintf("%s/%d", tc.name, size), func(b *testing.B) {
+				table := tc.make(Config[int]{})
+				for key := range size {
+					table.Put(key, key)
+				}
+				b.ResetTimer()
+				for i := range b.N {
+					table.Get(i % (2 * size)) //half of the lookups miss
+				}
+			})
+		}
+		b.Run(fmt.Sprintf("map/%d", size), func(b *testing.B) {
+			table := make(map[int]int)
+			for key := range size {
+				table[key] = key
+			}
+			b.ResetTimer()
+			for i := range b.N {
+				_ = table[i%(2*size)]
+			}
+		})
+	}
+}
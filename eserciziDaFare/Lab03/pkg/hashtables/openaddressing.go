@@ -0,0 +1,158 @@
+package hashtables
+
+type slotState uint8
+
+const (
+	slotEmpty slotState = iota
+	slotFull
+	slotDeleted
+)
+
+type slot[K comparable, V any] struct {
+	entry[K, V]
+	state slotState
+}
+
+// probeFunc returns the offset from the home slot for the i-th probe of a key
+type probeFunc func(hash uint64, i int) uint64
+
+// OpenAddressing stores every entry in the slot array itself, following a probe sequence from
+// the key's home slot on collision. Deleted entries leave tombstones that count towards the load
+// factor until the next resize (default load factor 0.5)
+type OpenAddressing[K comparable, V any] struct {
+	config Config[K]
+	slots  []slot[K, V]
+	n      int // full slots
+	used   int // full and deleted slots
+	probe  probeFunc
+	instrument
+}
+
+// NewLinearProbing creates an empty table that probes consecutive slots
+func NewLinearProbing[K comparable, V any](config Config[K]) *OpenAddressing[K, V] {
+	return newOpenAddressing[K, V](config, func(hash uint64, i int) uint64 {
+		return uint64(i)
+	})
+}
+
+// NewQuadraticProbing creates an empty table that probes at triangular number offsets,
+// which visits every slot when the capacity is a power of two
+func NewQuadraticProbing[K comparable, V any](config Config[K]) *OpenAddressing[K, V] {
+	return newOpenAddressing[K, V](config, func(hash uint64, i int) uint64 {
+		return uint64(i * (i + 1) / 2)
+	})
+}
+
+// NewDoubleHashing creates an empty table that probes in steps given by a second hash of the key.
+// The step is odd so it visits every slot when the capacity is a power of two
+func NewDoubleHashing[K comparable, V any](config Config[K]) *OpenAddressing[K, V] {
+	return newOpenAddressing[K, V](config, func(hash uint64, i int) uint64 {
+		return uint64(i) * (mix(hash) | 1)
+	})
+}
+
+func newOpenAddressing[K comparable, V any](config Config[K], probe probeFunc) *OpenAddressing[K, V] {
+	config = config.withDefaults(0.5)
+	config.requireSpareSlot()
+	return &OpenAddressing[K, V]{config: config, slots: make([]slot[K, V], config.InitialCapacity), probe: probe}
+}
+
+// find returns the slot holding key and true, otherwise the slot a new key should be stored in
+// (the first tombstone passed, or the empty slot that ended the search) and false
+func (t *OpenAddressing[K, V]) find(hash uint64, key K) (int, bool) {
+	mask := uint64(len(t.slots) - 1)
+	insertAt := -1
+	for i := 0; i < len(t.slots); i++ {
+		index := int((hash + t.probe(hash, i)) & mask)
+		s := &t.slots[index]
+		switch {
+		case s.state == slotEmpty:
+			t.recordProbe(i + 1)
+			if insertAt < 0 {
+				insertAt = index
+			}
+			return insertAt, false
+		case s.state == slotDeleted:
+			if insertAt < 0 {
+				insertAt = index
+			}
+		case s.hash == hash && s.key == key:
+			t.recordProbe(i + 1)
+			return index, true
+		}
+	}
+	t.recordProbe(len(t.slots))
+	return insertAt, false
+}
+
+func (t *OpenAddressing[K, V]) Put(key K, value V) {
+	hash := t.config.Hash(key)
+	index, found := t.find(hash, key)
+	if found {
+		t.slots[index].value = value
+		return
+	}
+	if index != int(hash&uint64(len(t.slots)-1)) {
+		t.collisions++
+	}
+	if t.slots[index].state == slotEmpty {
+		t.used++
+	}
+	t.slots[index] = slot[K, V]{entry: entry[K, V]{hash: hash, key: key, value: value}, state: slotFull}
+	t.n++
+	if t.config.overloaded(t.used, len(t.slots)) {
+		capacity := len(t.slots)
+		// Only grow when the table is full of live entries, otherwise rebuilding clears the tombstones
+		if t.config.overloaded(t.n, capacity) {
+			capacity *= t.config.GrowthFactor
+		}
+		t.resize(capacity)
+	}
+}
+
+func (t *OpenAddressing[K, V]) Get(key K) (V, bool) {
+	index, found := t.find(t.config.Hash(key), key)
+	if !found {
+		var blank V
+		return blank, false
+	}
+	return t.slots[index].value, true
+}
+
+func (t *OpenAddressing[K, V]) Delete(key K) bool {
+	index, found := t.find(t.config.Hash(key), key)
+	if !found {
+		return false
+	}
+	t.slots[index] = slot[K, V]{state: slotDeleted}
+	t.n--
+	return true
+}
+
+func (t *OpenAddressing[K, V]) resize(capacity int) {
+	old := t.slots
+	t.slots = make([]slot[K, V], capacity)
+	t.used = t.n
+	mask := uint64(capacity - 1)
+	for _, s := range old {
+		if s.state != slotFull {
+			continue
+		}
+		for i := 0; ; i++ {
+			index := (s.hash + t.probe(s.hash, i)) & mask
+			if t.slots[index].state == slotEmpty {
+				t.slots[index] = s
+				break
+			}
+		}
+	}
+	t.resizes++
+}
+
+func (t *OpenAddressing[K, V]) Len() int {
+	return t.n
+}
+
+func (t *OpenAddressing[K, V]) Stats() Stats {
+	return t.stats(t.n, len(t.slots))
+}
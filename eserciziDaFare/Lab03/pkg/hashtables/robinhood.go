@@ -0,0 +1,126 @@
+package hashtables
+
+type robinHoodSlot[K comparable, V any] struct {
+	entry[K, V]
+	full     bool
+	distance int // number of slots past the home slot
+}
+
+// RobinHood is a linear probing table where an inserted key takes the slot of any key closer to
+// its home slot, evening out probe lengths. Deletion shifts later entries back instead of leaving
+// tombstones (default load factor 0.9)
+type RobinHood[K comparable, V any] struct {
+	config Config[K]
+	slots  []robinHoodSlot[K, V]
+	n      int
+	instrument
+}
+
+// NewRobinHood creates an empty Robin Hood hashing table
+func NewRobinHood[K comparable, V any](config Config[K]) *RobinHood[K, V] {
+	config = config.withDefaults(0.9)
+	config.requireSpareSlot()
+	return &RobinHood[K, V]{config: config, slots: make([]robinHoodSlot[K, V], config.InitialCapacity)}
+}
+
+func (t *RobinHood[K, V]) mask() int {
+	return len(t.slots) - 1
+}
+
+// find returns the slot holding key, or -1. A search can stop early once it reaches a key closer to
+// its home than the searched key would be, as the key would have displaced it
+func (t *RobinHood[K, V]) find(hash uint64, key K) int {
+	index := int(hash) & t.mask()
+	for distance := 0; ; distance++ {
+		s := &t.slots[index]
+		if !s.full || s.distance < distance {
+			t.recordProbe(distance + 1)
+			return -1
+		}
+		if s.hash == hash && s.key == key {
+			t.recordProbe(distance + 1)
+			return index
+		}
+		index = (index + 1) & t.mask()
+	}
+}
+
+func (t *RobinHood[K, V]) Put(key K, value V) {
+	hash := t.config.Hash(key)
+	if index := t.find(hash, key); index >= 0 {
+		t.slots[index].value = value
+		return
+	}
+	if t.slots[int(hash)&t.mask()].full {
+		t.collisions++
+	}
+	t.insert(robinHoodSlot[K, V]{entry: entry[K, V]{hash: hash, key: key, value: value}, full: true})
+	t.n++
+	if t.config.overloaded(t.n, len(t.slots)) {
+		t.resize(len(t.slots) * t.config.GrowthFactor)
+	}
+}
+
+// insert places a new entry, swapping it with any richer entry met on the way
+func (t *RobinHood[K, V]) insert(carry robinHoodSlot[K, V]) {
+	index := int(carry.hash) & t.mask()
+	for carry.distance = 0; ; carry.distance++ {
+		s := &t.slots[index]
+		if !s.full {
+			*s = carry
+			return
+		}
+		if s.distance < carry.distance {
+			*s, carry = carry, *s
+		}
+		index = (index + 1) & t.mask()
+	}
+}
+
+func (t *RobinHood[K, V]) Get(key K) (V, bool) {
+	index := t.find(t.config.Hash(key), key)
+	if index < 0 {
+		var blank V
+		return blank, false
+	}
+	return t.slots[index].value, true
+}
+
+func (t *RobinHood[K, V]) Delete(key K) bool {
+	index := t.find(t.config.Hash(key), key)
+	if index < 0 {
+		return false
+	}
+	// Shift following displaced entries back one slot until reaching an empty slot or a key at home
+	for {
+		next := (index + 1) & t.mask()
+		if !t.slots[next].full || t.slots[next].distance == 0 {
+			t.slots[index] = robinHoodSlot[K, V]{}
+			break
+		}
+		t.slots[index] = t.slots[next]
+		t.slots[index].distance--
+		index = next
+	}
+	t.n--
+	return true
+}
+
+func (t *RobinHood[K, V]) resize(capacity int) {
+	old := t.slots
+	t.slots = make([]robinHoodSlot[K, V], capacity)
+	for _, s := range old {
+		if s.full {
+			t.insert(s)
+		}
+	}
+	t.resizes++
+}
+
+func (t *RobinHood[K, V]) Len() int {
+	return t.n
+}
+
+func (t *RobinHood[K, V]) Stats() Stats {
+	return t.stats(t.n, len(t.slots))
+}
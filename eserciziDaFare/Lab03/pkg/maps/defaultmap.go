@@ -0,0 +1,108 @@
+package maps
+
+// DefaultMap is a map that creates a value with its factory the first time a missing key is accessed
+type DefaultMap[K comparable, V any] struct {
+	data    map[K]V
+	factory func() V
+}
+
+// NewDefaultMap creates an empty map that fills missing keys using the factory
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{data: make(map[K]V), factory: factory}
+}
+
+// NewDefaultMapFromMap wraps an existing map, which is shared rather than copied
+func NewDefaultMapFromMap[K comparable, V any](data map[K]V, factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{data: data, factory: factory}
+}
+
+// Get will return the value for key, inserting a new value from the factory if it is missing
+func (d *DefaultMap[K, V]) Get(key K) V {
+	return GetOrInsert(d.data, key, d.factory)
+}
+
+// Lookup will return the value for key and whether it exists, without inserting anything
+func (d *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	val, ok := d.data[key]
+	return val, ok
+}
+
+// Set will replace the value for key
+func (d *DefaultMap[K, V]) Set(key K, val V) {
+	d.data[key] = val
+}
+
+// Update will replace the value for key with the result of op, starting from the factory value
+// if the key is missing, and return the new value
+func (d *DefaultMap[K, V]) Update(key K, op func(V) V) V {
+	val := op(d.Get(key))
+	d.data[key] = val
+	return val
+}
+
+// Delete will remove the key
+func (d *DefaultMap[K, V]) Delete(key K) {
+	delete(d.data, key)
+}
+
+// Len returns the number of keys in the map
+func (d *DefaultMap[K, V]) Len() int {
+	return len(d.data)
+}
+
+// Map returns the underlying map, for use with the other helpers in this package
+func (d *DefaultMap[K, V]) Map() map[K]V {
+	return d.data
+}
+
+// GetOrInsert will return the value for key, inserting a new value from the factory if it is missing
+func GetOrInsert[K comparable, V any](source map[K]V, key K, factory func() V) V {
+	if val, ok := source[key]; ok {
+		return val
+	}
+	val := factory()
+	source[key] = val
+	return val
+}
+
+// Update will replace the value for key with the result of op, starting from the zero value if
+// the key is missing, and return the new value
+func Update[K comparable, V any](source map[K]V, key K, op func(V) V) V {
+	val := op(source[key])
+	source[key] = val
+	return val
+}
+
+// NestedGet will return the value stored under both keys and whether it exists
+func NestedGet[K1, K2 comparable, V any](source map[K1]map[K2]V, outer K1, inner K2) (V, bool) {
+	val, ok := source[outer][inner]
+	return val, ok
+}
+
+// NestedSet will store the value under both keys, creating the inner map if needed
+func NestedSet[K1, K2 comparable, V any](source map[K1]map[K2]V, outer K1, inner K2, val V) {
+	Inner(source, outer)[inner] = val
+}
+
+// NestedUpdate will replace the value stored under both keys with the result of op, starting from
+// the zero value if it is missing and creating the inner map if needed, and return the new value
+func NestedUpdate[K1, K2 comparable, V any](source map[K1]map[K2]V, outer K1, inner K2, op func(V) V) V {
+	return Update(Inner(source, outer), inner, op)
+}
+
+// NestedDelete will remove the value stored under both keys, removing the inner map once it is empty
+func NestedDelete[K1, K2 comparable, V any](source map[K1]map[K2]V, outer K1, inner K2) {
+	innerMap, ok := source[outer]
+	if !ok {
+		return
+	}
+	delete(innerMap, inner)
+	if len(innerMap) == 0 {
+		delete(source, outer)
+	}
+}
+
+// Inner will return the inner map stored under the key, creating it if needed
+func Inner[K1, K2 comparable, V any](source map[K1]map[K2]V, outer K1) map[K2]V {
+	return GetOrInsert(source, outer, func() map[K2]V { return make(map[K2]V) })
+}
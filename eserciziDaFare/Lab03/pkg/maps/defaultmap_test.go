@@ -0,0 +1,90 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultMap(t *testing.T) {
+	calls := 0
+	d := NewDefaultMap[string](func() []int {
+		calls++
+		return []int{}
+	})
+	if _, ok := d.Lookup("a"); ok || d.Len() != 0 {
+		t.Fatal("Lookup() of a missing key reported it present or inserted it")
+	}
+	d.Set("a", append(d.Get("a"), 1))
+	d.Set("a", append(d.Get("a"), 2))
+	if got, _ := d.Lookup("a"); !reflect.DeepEqual(got, []int{1, 2}) || calls != 1 {
+		t.Errorf("Get() after Set() = %v with %d factory calls, want [1 2] with 1", got, calls)
+	}
+	got := d.Update("b", func(v []int) []int { return append(v, 7) })
+	if !reflect.DeepEqual(got, []int{7}) || calls != 2 {
+		t.Errorf("Update() of a missing key = %v with %d factory calls", got, calls)
+	}
+	got = d.Update("b", func(v []int) []int { return append(v, 8) })
+	if stored, _ := d.Lookup("b"); !reflect.DeepEqual(got, []int{7, 8}) || !reflect.DeepEqual(stored, got) || calls != 2 {
+		t.Errorf("Update() of an existing key = %v, stored %v with %d factory calls", got, stored, calls)
+	}
+	d.Delete("a")
+	if _, ok := d.Lookup("a"); ok || d.Len() != 1 {
+		t.Errorf("Delete() left %v", d.Map())
+	}
+}
+
+func TestDefaultMapSharesSource(t *testing.T) {
+	source := map[string]int{"x": 3}
+	d := NewDefaultMapFromMap(source, func() int { return 10 })
+	if d.Get("y") != 10 || source["y"] != 10 {
+		t.Errorf("Get() did not insert into the shared map: %v", source)
+	}
+	if d.Update("x", func(v int) int { return v * 2 }) != 6 || source["x"] != 6 {
+		t.Errorf("Update() did not change the shared map: %v", source)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	counts := map[rune]int{}
+	for _, r := range "banana" {
+		Update(counts, r, func(v int) int { return v + 1 })
+	}
+	if !reflect.DeepEqual(counts, map[rune]int{'b': 1, 'a': 3, 'n': 2}) {
+		t.Errorf("Update() counts = %v", counts)
+	}
+}
+
+func TestNestedMaps(t *testing.T) {
+	graph := map[string]map[string]int{}
+	NestedSet(graph, "a", "b", 1)
+	NestedSet(graph, "a", "c", 2)
+	NestedUpdate(graph, "b", "c", func(v int) int { return v + 5 })
+	NestedUpdate(graph, "a", "b", func(v int) int { return v + 5 })
+	want := map[string]map[string]int{"a": {"b": 6, "c": 2}, "b": {"c": 5}}
+	if !reflect.DeepEqual(graph, want) {
+		t.Fatalf("graph = %v, want %v", graph, want)
+	}
+	if v, ok := NestedGet(graph, "a", "c"); !ok || v != 2 {
+		t.Errorf("NestedGet(a, c) = %d, %v", v, ok)
+	}
+	if _, ok := NestedGet(graph, "z", "c"); ok {
+		t.Error("NestedGet() of a missing outer key reported it present")
+	}
+	if _, ok := graph["z"]; ok {
+		t.Error("NestedGet() inserted the missing outer key")
+	}
+
+	NestedDelete(graph, "a", "b")
+	if _, ok := graph["a"]; !ok {
+		t.Fatal("NestedDelete() removed an inner map that still has entries")
+	}
+	NestedDelete(graph, "a", "c")
+	if _, ok := graph["a"]; ok {
+		t.Error("NestedDelete() kept the empty inner map")
+	}
+	NestedDelete(graph, "b", "missing")
+	NestedDelete(graph, "missing", "c")
+	if !reflect.DeepEqual(graph, map[string]map[string]int{"b": {"c": 5}}) {
+		t.Errorf("deleting missing keys changed the map: %v", graph)
+	}
+}
@@ -38,7 +38,8 @@ func MaxKey[T constraints.Ordered, U any](source map[T]U) T {
 	return maxKey
 }
 
-// MaxValue will return the entry with the largest value
+// MaxValue will return the entry with the largest value. Which of several tied entries is returned
+// may differ between calls, MaxValueWithTie replaces it for ordered keys when that matters
 func MaxValue[T comparable, U constraints.Integer](source map[T]U) (T, U) {
 	started := false
 	var maxKey T
@@ -57,12 +58,14 @@ func MaxValue[T comparable, U constraints.Integer](source map[T]U) (T, U) {
 	return maxKey, maxVal
 }
 
-// MinValue will return the entry with the smallest value
+// MinValue will return the entry with the smallest value. Which of several tied entries is returned
+// may differ between calls, MinValueWithTie replaces it for ordered keys when that matters
 func MinValue[T comparable, U constraints.Integer](source map[T]U) (T, U) {
 	return MinMappedValue(source, func(u U) U { return u })
 }
 
-// MinMappedValue will return the entry with the smallest value returned from func
+// MinMappedValue will return the entry with the smallest value returned from func. Which of several tied
+// entries is returned may differ between calls, MinMappedValueWithTie replaces it for ordered keys when that matters
 func MinMappedValue[T comparable, U any, V constraints.Ordered](source map[T]U, op func(U) V) (T, U) {
 	started := false
 	var minKey T
@@ -107,7 +110,9 @@ func First[T comparable, U any](source map[T]U, predicate func(k T, v U) bool) (
 	return blankKey, blankVal, false
 }
 
-// AnyKey returns an unspecified key from the map
+// AnyKey returns an unspecified key from the map, or the zero value for an empty map. The key
+// may differ between calls as map iteration is randomised, MinKey replaces it for ordered keys
+// when a deterministic choice is needed
 func AnyKey[T comparable, U any](source map[T]U) T {
 	for k := range source {
 		return k
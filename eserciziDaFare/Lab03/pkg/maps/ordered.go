@@ -0,0 +1,163 @@
+package maps
+
+import (
+	"adventofcode2021/pkg/slices"
+	"adventofcode2021/pkg/tuples"
+	"constraints"
+	"fmt"
+)
+
+// TieBreak decides which entry wins when several share the best value
+type TieBreak int
+
+const (
+	// PreferSmallestKey picks the entry with the smallest key among ties
+	PreferSmallestKey TieBreak = iota
+	// PreferLargestKey picks the entry with the largest key among ties
+	PreferLargestKey
+)
+
+// SortedKeys returns all keys in the map in ascending order
+func SortedKeys[T constraints.Ordered, U any](source map[T]U) []T {
+	keys := Keys(source)
+	slices.QuickSortRandom(keys, nil)
+	return keys
+}
+
+// SortedEntries returns all key value pairs in the map in ascending key order
+func SortedEntries[T constraints.Ordered, U any](source map[T]U) []tuples.Pair[T, U] {
+	return slices.Map(SortedKeys(source), func(k T) tuples.Pair[T, U] {
+		return tuples.Pair[T, U]{Key: k, Value: source[k]}
+	})
+}
+
+// Values returns all values in the map (undefined order)
+func Values[T comparable, U any](source map[T]U) []U {
+	result := make([]U, 0, len(source))
+	for _, v := range source {
+		result = append(result, v)
+	}
+	return result
+}
+
+// MinKey will return the smallest key, or the zero value for an empty map. It is the
+// deterministic replacement for AnyKey
+func MinKey[T constraints.Ordered, U any](source map[T]U) T {
+	started := false
+	var minKey T
+	for key := range source {
+		if !started || key < minKey {
+			minKey = key
+			started = true
+		}
+	}
+	return minKey
+}
+
+// Invert swaps the keys and values of the map, panicking if two keys share a value
+func Invert[T, U comparable](source map[T]U) map[U]T {
+	result := make(map[U]T, len(source))
+	for k, v := range source {
+		if _, ok := result[v]; ok {
+			panic(fmt.Sprintf("unable to invert map, repeated value %v", v))
+		}
+		result[v] = k
+	}
+	return result
+}
+
+// Merge creates a new map with the entries of both maps, using combine to resolve keys present in both
+func Merge[T comparable, U any](first, second map[T]U, combine func(a, b U) U) map[T]U {
+	result := make(map[T]U, len(first)+len(second))
+	for k, v := range first {
+		result[k] = v
+	}
+	for k, v := range second {
+		if existing, ok := result[k]; ok {
+			result[k] = combine(existing, v)
+		} else {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// FilterMap will generate a new map containing the entries that match the predicate
+func FilterMap[T comparable, U any](source map[T]U, predicate func(k T, v U) bool) map[T]U {
+	result := make(map[T]U)
+	for k, v := range source {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MapValues will generate a new map with the same keys and each value converted by the selector
+func MapValues[T comparable, U, V any](source map[T]U, selector func(U) V) map[T]V {
+	result := make(map[T]V, len(source))
+	for k, v := range source {
+		result[k] = selector(v)
+	}
+	return result
+}
+
+// GroupBy will split the map into groups of entries by the key returned from the selector
+func GroupBy[T comparable, U any, K comparable](source map[T]U, selector func(k T, v U) K) map[K]map[T]U {
+	result := make(map[K]map[T]U)
+	for k, v := range source {
+		group := selector(k, v)
+		if result[group] == nil {
+			result[group] = make(map[T]U)
+		}
+		result[group][k] = v
+	}
+	return result
+}
+
+// keysInTieOrder returns the keys so that the first of any tie is the one the policy prefers
+func keysInTieOrder[T constraints.Ordered, U any](source map[T]U, tie TieBreak) []T {
+	keys := SortedKeys(source)
+	if tie == PreferLargestKey {
+		keys = slices.Reverse(keys)
+	}
+	return keys
+}
+
+// MaxValueWithTie will return the entry with the largest value, resolving ties with the policy
+func MaxValueWithTie[T constraints.Ordered, U constraints.Integer](source map[T]U, tie TieBreak) (T, U) {
+	return MaxMappedValueWithTie(source, func(u U) U { return u }, tie)
+}
+
+// MinValueWithTie will return the entry with the smallest value, resolving ties with the policy
+func MinValueWithTie[T constraints.Ordered, U constraints.Integer](source map[T]U, tie TieBreak) (T, U) {
+	return MinMappedValueWithTie(source, func(u U) U { return u }, tie)
+}
+
+// MaxMappedValueWithTie will return the entry with the largest value returned from func, resolving ties with the policy
+func MaxMappedValueWithTie[T constraints.Ordered, U any, V constraints.Ordered](source map[T]U, op func(U) V, tie TieBreak) (T, U) {
+	return bestMappedValue(source, op, tie, func(a, b V) bool { return a > b })
+}
+
+// MinMappedValueWithTie will return the entry with the smallest value returned from func, resolving ties with the policy
+func MinMappedValueWithTie[T constraints.Ordered, U any, V constraints.Ordered](source map[T]U, op func(U) V, tie TieBreak) (T, U) {
+	return bestMappedValue(source, op, tie, func(a, b V) bool { return a < b })
+}
+
+func bestMappedValue[T constraints.Ordered, U any, V constraints.Ordered](source map[T]U, op func(U) V, tie TieBreak, better func(a, b V) bool) (T, U) {
+	started := false
+	var bestKey T
+	var bestVal U
+	var bestCompare V
+	for _, key := range keysInTieOrder(source, tie) {
+		val := source[key]
+		testCompare := op(val)
+		if !started || better(testCompare, bestCompare) {
+			bestKey = key
+			bestVal = val
+			bestCompare = testCompare
+			started = true
+		}
+	}
+	return bestKey, bestVal
+}
@@ -0,0 +1,100 @@
+package maps
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSortedKeysAndEntries(t *testing.T) {
+	source := map[string]int{"d": 4, "a": 1, "c": 3, "b": 2}
+	if got := SortedKeys(source); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("SortedKeys() = %v", got)
+	}
+	want := []tuples.Pair[string, int]{
+		tuples.NewPair("a", 1), tuples.NewPair("b", 2), tuples.NewPair("c", 3), tuples.NewPair("d", 4),
+	}
+	if got := SortedEntries(source); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedEntries() = %v", got)
+	}
+	if got := SortedKeys(map[int]bool{}); len(got) != 0 {
+		t.Errorf("SortedKeys() of an empty map = %v", got)
+	}
+	if got := MinKey(map[int]string{5: "", -2: "", 9: ""}); got != -2 {
+		t.Errorf("MinKey() = %d, want -2", got)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := Invert(map[string]int{"one": 1, "two": 2})
+	if !reflect.DeepEqual(got, map[int]string{1: "one", 2: "two"}) {
+		t.Errorf("Invert() = %v", got)
+	}
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, "repeated value 1") {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	Invert(map[string]int{"one": 1, "uno": 1})
+	t.Error("Invert() accepted a repeated value")
+}
+
+func TestMergeFilterGroup(t *testing.T) {
+	first := map[string]int{"a": 1, "b": 2}
+	second := map[string]int{"b": 10, "c": 3}
+	got := Merge(first, second, func(a, b int) int { return a + b })
+	if !reflect.DeepEqual(got, map[string]int{"a": 1, "b": 12, "c": 3}) {
+		t.Errorf("Merge() = %v", got)
+	}
+	if !reflect.DeepEqual(first, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("Merge() modified its input: %v", first)
+	}
+	odd := FilterMap(got, func(k string, v int) bool { return v%2 == 1 })
+	if !reflect.DeepEqual(odd, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("FilterMap() = %v", odd)
+	}
+	doubled := MapValues(got, func(v int) int { return v * 2 })
+	if !reflect.DeepEqual(doubled, map[string]int{"a": 2, "b": 24, "c": 6}) {
+		t.Errorf("MapValues() = %v", doubled)
+	}
+	groups := GroupBy(got, func(k string, v int) bool { return v > 2 })
+	if !reflect.DeepEqual(groups, map[bool]map[string]int{false: {"a": 1}, true: {"b": 12, "c": 3}}) {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+}
+
+func TestWithTiePolicies(t *testing.T) {
+	// Several keys share both the largest and the smallest value
+	source := map[string]int{"m": 5, "c": 5, "x": 5, "a": 1, "q": 1, "k": 3}
+	// Repeat so that a policy relying on map iteration order would be caught
+	for range 50 {
+		if k, v := MaxValueWithTie(source, PreferSmallestKey); k != "c" || v != 5 {
+			t.Fatalf("MaxValueWithTie(smallest) = %s, %d, want c, 5", k, v)
+		}
+		if k, v := MaxValueWithTie(source, PreferLargestKey); k != "x" || v != 5 {
+			t.Fatalf("MaxValueWithTie(largest) = %s, %d, want x, 5", k, v)
+		}
+		if k, v := MinValueWithTie(source, PreferSmallestKey); k != "a" || v != 1 {
+			t.Fatalf("MinValueWithTie(smallest) = %s, %d, want a, 1", k, v)
+		}
+		if k, v := MinValueWithTie(source, PreferLargestKey); k != "q" || v != 1 {
+			t.Fatalf("MinValueWithTie(largest) = %s, %d, want q, 1", k, v)
+		}
+	}
+	words := map[int]string{4: "dd", 1: "bb", 7: "a", 2: "ccc", 9: "eee"}
+	length := func(s string) int { return len(s) }
+	if k, v := MaxMappedValueWithTie(words, length, PreferSmallestKey); k != 2 || v != "ccc" {
+		t.Errorf("MaxMappedValueWithTie(smallest) = %d, %s", k, v)
+	}
+	if k, v := MaxMappedValueWithTie(words, length, PreferLargestKey); k != 9 || v != "eee" {
+		t.Errorf("MaxMappedValueWithTie(largest) = %d, %s", k, v)
+	}
+	if k, v := MinMappedValueWithTie(words, length, PreferLargestKey); k != 7 || v != "a" {
+		t.Errorf("MinMappedValueWithTie(largest) = %d, %s", k, v)
+	}
+	if k, v := MaxValueWithTie(map[string]int{}, PreferLargestKey); k != "" || v != 0 {
+		t.Errorf("MaxValueWithTie() of an empty map = %q, %d", k, v)
+	}
+}
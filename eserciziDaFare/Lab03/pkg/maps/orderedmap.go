@@ -0,0 +1,157 @@
+package maps
+
+import "iter"
+
+// OrderedMap is a map that remembers the order keys were inserted in. Entries can be moved
+// to either end, and all operations other than iteration are O(1)
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+	root    orderedEntry[K, V] // sentinel, root.next is the front and root.prev is the back
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedEntry[K, V]
+}
+
+// NewOrderedMap creates an empty insertion ordered map
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{entries: make(map[K]*orderedEntry[K, V])}
+	m.root.next = &m.root
+	m.root.prev = &m.root
+	return m
+}
+
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+func (m *OrderedMap[K, V]) linkAfter(e, at *orderedEntry[K, V]) {
+	e.prev = at
+	e.next = at.next
+	at.next.prev = e
+	at.next = e
+}
+
+// Set will store the value for key. New keys are added at the back, existing keys keep their position
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		return
+	}
+	e := &orderedEntry[K, V]{key: key, value: value}
+	m.linkAfter(e, m.root.prev)
+	m.entries[key] = e
+}
+
+// Get will return the value for key and whether it exists
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.entries[key]; ok {
+		return e.value, true
+	}
+	var blank V
+	return blank, false
+}
+
+// ContainsKey will return true if the provided key is in the map
+func (m *OrderedMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Delete will remove the key, returning false if it was not in the map
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	delete(m.entries, key)
+	return true
+}
+
+// Len returns the number of keys in the map
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// MoveToFront will move the key to the front of the order, returning false if it was not in the map
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.linkAfter(e, &m.root)
+	return true
+}
+
+// MoveToBack will move the key to the back of the order, returning false if it was not in the map
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.linkAfter(e, m.root.prev)
+	return true
+}
+
+// Front will return the first entry in the order, false is returned for an empty map
+func (m *OrderedMap[K, V]) Front() (K, V, bool) {
+	return m.entryOf(m.root.next)
+}
+
+// Back will return the last entry in the order, false is returned for an empty map
+func (m *OrderedMap[K, V]) Back() (K, V, bool) {
+	return m.entryOf(m.root.prev)
+}
+
+// PopFront will remove and return the first entry in the order, false is returned for an empty map
+func (m *OrderedMap[K, V]) PopFront() (K, V, bool) {
+	k, v, ok := m.Front()
+	if ok {
+		m.Delete(k)
+	}
+	return k, v, ok
+}
+
+// PopBack will remove and return the last entry in the order, false is returned for an empty map
+func (m *OrderedMap[K, V]) PopBack() (K, V, bool) {
+	k, v, ok := m.Back()
+	if ok {
+		m.Delete(k)
+	}
+	return k, v, ok
+}
+
+func (m *OrderedMap[K, V]) entryOf(e *orderedEntry[K, V]) (K, V, bool) {
+	if e == &m.root {
+		var blankKey K
+		var blankVal V
+		return blankKey, blankVal, false
+	}
+	return e.key, e.value, true
+}
+
+// All returns an iterator over the entries from front to back. The map must not be modified during iteration
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.root.next; e != &m.root; e = e.next {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns all keys in the map from front to back
+func (m *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, 0, len(m.entries))
+	for k := range m.All() {
+		result = append(result, k)
+	}
+	return result
+}
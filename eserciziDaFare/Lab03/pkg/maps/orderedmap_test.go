@@ -0,0 +1,69 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMapInsertionOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	for i, k := range []string{"c", "a", "d", "b"} {
+		m.Set(k, i)
+	}
+	m.Set("a", 10) //updating keeps the position
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"c", "a", "d", "b"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+	if v, ok := m.Get("a"); !ok || v != 10 {
+		t.Errorf("Get(a) = %d, %v", v, ok)
+	}
+	if !m.Delete("d") || m.Delete("d") || m.ContainsKey("d") {
+		t.Error("Delete(d) did not remove the key exactly once")
+	}
+	m.Set("d", 4)
+	var keys []string
+	var values []int
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(keys, []string{"c", "a", "b", "d"}) || !reflect.DeepEqual(values, []int{0, 10, 3, 4}) {
+		t.Errorf("All() = %v, %v", keys, values)
+	}
+}
+
+func TestOrderedMapMoveAndPop(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	for _, k := range []int{1, 2, 3, 4} {
+		m.Set(k, string(rune('a'+k-1)))
+	}
+	if !m.MoveToFront(3) || !m.MoveToBack(1) || m.MoveToFront(9) || m.MoveToBack(9) {
+		t.Fatal("Move reported the wrong membership")
+	}
+	if got := m.Keys(); !reflect.DeepEqual(got, []int{3, 2, 4, 1}) {
+		t.Errorf("Keys() after moves = %v, want [3 2 4 1]", got)
+	}
+	if k, v, ok := m.Front(); !ok || k != 3 || v != "c" {
+		t.Errorf("Front() = %d, %q, %v", k, v, ok)
+	}
+	if k, v, ok := m.Back(); !ok || k != 1 || v != "a" {
+		t.Errorf("Back() = %d, %q, %v", k, v, ok)
+	}
+	if k, _, _ := m.PopFront(); k != 3 {
+		t.Errorf("PopFront() = %d, want 3", k)
+	}
+	if k, _, _ := m.PopBack(); k != 1 {
+		t.Errorf("PopBack() = %d, want 1", k)
+	}
+	if got := m.Keys(); !reflect.DeepEqual(got, []int{2, 4}) || m.Len() != 2 {
+		t.Errorf("Keys() after pops = %v", got)
+	}
+	m.PopFront()
+	m.PopFront()
+	if k, v, ok := m.PopBack(); ok || k != 0 || v != "" {
+		t.Errorf("PopBack() of an empty map = %d, %q, %v", k, v, ok)
+	}
+	if _, _, ok := m.Front(); ok {
+		t.Error("Front() of an empty map reported an entry")
+	}
+}
@@ -0,0 +1,89 @@
+package maps
+
+import (
+	"constraints"
+	"hash/maphash"
+	"sync"
+)
+
+const syncCounterShards = 32
+
+// SyncCounter is a map of counts that is safe for concurrent use. Keys are spread over
+// a number of independently locked shards to reduce lock contention
+type SyncCounter[T comparable, U constraints.Integer] struct {
+	seed   maphash.Seed
+	shards [syncCounterShards]syncCounterShard[T, U]
+}
+
+type syncCounterShard[T comparable, U constraints.Integer] struct {
+	sync.RWMutex
+	data map[T]U
+}
+
+// NewSyncCounter generates an empty concurrency safe counter map
+func NewSyncCounter[T comparable, U constraints.Integer]() *SyncCounter[T, U] {
+	c := &SyncCounter[T, U]{seed: maphash.MakeSeed()}
+	for i := range c.shards {
+		c.shards[i].data = make(map[T]U)
+	}
+	return c
+}
+
+func (c *SyncCounter[T, U]) shard(key T) *syncCounterShard[T, U] {
+	return &c.shards[maphash.Comparable(c.seed, key)%syncCounterShards]
+}
+
+// Add will atomically add delta to the count for key, returning the new count
+func (c *SyncCounter[T, U]) Add(key T, delta U) U {
+	sh := c.shard(key)
+	sh.Lock()
+	defer sh.Unlock()
+	sh.data[key] += delta
+	return sh.data[key]
+}
+
+// Increment will atomically add one to the count for key, returning the new count
+func (c *SyncCounter[T, U]) Increment(key T) U {
+	return c.Add(key, 1)
+}
+
+// Get will return the count for key, zero if it has never been counted
+func (c *SyncCounter[T, U]) Get(key T) U {
+	sh := c.shard(key)
+	sh.RLock()
+	defer sh.RUnlock()
+	return sh.data[key]
+}
+
+// Delete will remove the count for key
+func (c *SyncCounter[T, U]) Delete(key T) {
+	sh := c.shard(key)
+	sh.Lock()
+	delete(sh.data, key)
+	sh.Unlock()
+}
+
+// Len returns the number of keys counted
+func (c *SyncCounter[T, U]) Len() int {
+	total := 0
+	for i := range c.shards {
+		c.shards[i].RLock()
+		total += len(c.shards[i].data)
+		c.shards[i].RUnlock()
+	}
+	return total
+}
+
+// Snapshot will copy the current counts into a plain map. Concurrent modifications
+// made while the snapshot is taken may or may not be included
+func (c *SyncCounter[T, U]) Snapshot() map[T]U {
+	result := make(map[T]U)
+	for i := range c.shards {
+		c.shards[i].RLock()
+		for k, v := range c.shards[i].data {
+			result[k] = v
+		}
+		c.shards[i].RUnlock()
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package maps
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncCounterConcurrentIncrement(t *testing.T) {
+	const workers = 16
+	const keys = 100
+	const rounds = 50
+	c := NewSyncCounter[int, int]()
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				for k := range keys {
+					c.Increment(k)
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() { //snapshots taken while counting must not race
+		defer wg.Done()
+		for range rounds {
+			for _, v := range c.Snapshot() {
+				if v <= 0 || v > workers*rounds {
+					t.Errorf("unexpected count %d", v)
+				}
+			}
+		}
+	}()
+	wg.Wait()
+	if c.Len() != keys {
+		t.Errorf("Len() = %d, want %d", c.Len(), keys)
+	}
+	for k := range keys {
+		if got := c.Get(k); got != workers*rounds {
+			t.Errorf("Get(%d) = %d, want %d", k, got, workers*rounds)
+		}
+	}
+}
+
+func TestSyncCounterAddDelete(t *testing.T) {
+	c := NewSyncCounter[string, int64]()
+	if got := c.Add("a", 5); got != 5 {
+		t.Errorf("Add() = %d, want 5", got)
+	}
+	if got := c.Add("a", -2); got != 3 {
+		t.Errorf("Add() = %d, want 3", got)
+	}
+	c.Delete("a")
+	if c.Get("a") != 0 || c.Len() != 0 {
+		t.Errorf("after Delete Get() = %d, Len() = %d", c.Get("a"), c.Len())
+	}
+}
@@ -0,0 +1,223 @@
+package matrices
+
+import (
+	"constraints"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// ErrDimensionMismatch is returned when the dimensions of matrices do not suit an operation
+var ErrDimensionMismatch = errors.New("mismatching matrix dimensions")
+
+func dimensionError[T any](op string, a, b Matrix[T]) error {
+	return fmt.Errorf("%w: %s of %dx%d and %dx%d", ErrDimensionMismatch, op, a.Rows, a.Columns, b.Rows, b.Columns)
+}
+
+// NewIdentity creates the n by n identity matrix, n must be positive
+func NewIdentity[T constraints.Integer](n int) IntMatrix[T] {
+	if n <= 0 {
+		panic(fmt.Sprintf("identity matrix size must be positive, got %d", n))
+	}
+	result := NewIntMatrixFromBase(NewMatrix[T](n, n))
+	for i := 0; i < n; i++ {
+		result.data[i][i] = 1
+	}
+	return result
+}
+
+// elementWise combines matrices of the same dimensions element by element
+func (m IntMatrix[T]) elementWise(op string, other IntMatrix[T], combine func(a, b T) T) (IntMatrix[T], error) {
+	if m.Rows != other.Rows || m.Columns != other.Columns {
+		return IntMatrix[T]{}, dimensionError(op, m.Matrix, other.Matrix)
+	}
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, m.Columns))
+	m.ForEach(func(x, y int, value T) {
+		result.data[y][x] = combine(value, other.data[y][x])
+	})
+	return result, nil
+}
+
+// Add returns the element wise sum of the matrices
+func (m IntMatrix[T]) Add(other IntMatrix[T]) (IntMatrix[T], error) {
+	return m.elementWise("add", other, func(a, b T) T { return a + b })
+}
+
+// Sub returns the element wise difference of the matrices
+func (m IntMatrix[T]) Sub(other IntMatrix[T]) (IntMatrix[T], error) {
+	return m.elementWise("sub", other, func(a, b T) T { return a - b })
+}
+
+// Hadamard returns the element wise product of the matrices
+func (m IntMatrix[T]) Hadamard(other IntMatrix[T]) (IntMatrix[T], error) {
+	return m.elementWise("hadamard product", other, func(a, b T) T { return a * b })
+}
+
+// Scale returns the matrix with every element multiplied by factor
+func (m IntMatrix[T]) Scale(factor T) IntMatrix[T] {
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, m.Columns))
+	m.ForEach(func(x, y int, value T) {
+		result.data[y][x] = value * factor
+	})
+	return result
+}
+
+// Transpose returns the matrix with its rows and columns swapped
+func (m IntMatrix[T]) Transpose() IntMatrix[T] {
+	return NewIntMatrixFromBase(m.Matrix.Transpose())
+}
+
+// multiply returns the matrix product, accumulating each entry with mulAdd(acc, a, b)
+func (m IntMatrix[T]) multiply(other IntMatrix[T], mulAdd func(acc, a, b T) T) (IntMatrix[T], error) {
+	if m.Columns != other.Rows {
+		return IntMatrix[T]{}, dimensionError("multiply", m.Matrix, other.Matrix)
+	}
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, other.Columns))
+	for y, row := range m.data {
+		out := result.data[y]
+		for k, a := range row {
+			if a == 0 {
+				continue
+			}
+			for x, b := range other.data[k] {
+				out[x] = mulAdd(out[x], a, b)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Mul returns the matrix product m × other
+func (m IntMatrix[T]) Mul(other IntMatrix[T]) (IntMatrix[T], error) {
+	return m.multiply(other, func(acc, a, b T) T { return acc + a*b })
+}
+
+// MulMod returns the matrix product m × other with every entry reduced modulo mod. Intermediate
+// products use 128 bits so any positive modulus is safe from overflow
+func (m IntMatrix[T]) MulMod(other IntMatrix[T], mod T) (IntMatrix[T], error) {
+	checkModulus(mod)
+	a, b := m.Mod(mod), other.Mod(mod)
+	return a.multiply(b, func(acc, x, y T) T { return addMod(acc, mulMod(x, y, mod), mod) })
+}
+
+// Pow returns the matrix raised to the power n using exponentiation by squaring, in O(log n)
+// multiplications. The zero power is the identity, so an empty matrix is rejected as it has none
+func (m IntMatrix[T]) Pow(n uint64) (IntMatrix[T], error) {
+	return m.pow(n, IntMatrix[T].Mul)
+}
+
+// PowMod returns the matrix raised to the power n with every entry reduced modulo mod, see Pow and MulMod
+func (m IntMatrix[T]) PowMod(n uint64, mod T) (IntMatrix[T], error) {
+	checkModulus(mod)
+	return m.Mod(mod).pow(n, func(a, b IntMatrix[T]) (IntMatrix[T], error) { return a.MulMod(b, mod) })
+}
+
+func (m IntMatrix[T]) pow(n uint64, mul func(a, b IntMatrix[T]) (IntMatrix[T], error)) (IntMatrix[T], error) {
+	if m.Rows != m.Columns || m.Rows == 0 {
+		return IntMatrix[T]{}, dimensionError("power", m.Matrix, m.Matrix)
+	}
+	result := NewIdentity[T](m.Rows)
+	base := m
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result, _ = mul(result, base)
+		}
+		if n > 1 {
+			base, _ = mul(base, base)
+		}
+	}
+	return result, nil
+}
+
+// Mod returns the matrix with every element reduced into [0, mod)
+func (m IntMatrix[T]) Mod(mod T) IntMatrix[T] {
+	checkModulus(mod)
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, m.Columns))
+	m.ForEach(func(x, y int, value T) {
+		value %= mod
+		if value < 0 {
+			value += mod
+		}
+		result.data[y][x] = value
+	})
+	return result
+}
+
+func checkModulus[T constraints.Integer](mod T) {
+	if mod <= 0 {
+		panic(fmt.Sprintf("modulus must be positive, got %d", mod))
+	}
+}
+
+// mulMod returns a*b mod m for a, b in [0, m) without overflow
+func mulMod[T constraints.Integer](a, b, m T) T {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	_, rem := bits.Div64(hi, lo, uint64(m))
+	return T(rem)
+}
+
+// addMod returns a+b mod m for a, b in [0, m) without overflow
+func addMod[T constraints.Integer](a, b, m T) T {
+	sum := uint64(a) + uint64(b)
+	if sum < uint64(a) || sum >= uint64(m) {
+		sum -= uint64(m)
+	}
+	return T(sum)
+}
+
+// PowBig returns the matrix raised to the power n with exact arbitrary precision entries, for results
+// that would overflow T. Uses exponentiation by squaring, see Pow
+func (m IntMatrix[T]) PowBig(n uint64) ([][]*big.Int, error) {
+	if m.Rows != m.Columns || m.Rows == 0 {
+		return nil, dimensionError("power", m.Matrix, m.Matrix)
+	}
+	result := newBigMatrix(m.Rows)
+	base := newBigMatrix(m.Rows)
+	for i := range result {
+		result[i][i].SetInt64(1)
+	}
+	m.ForEach(func(x, y int, value T) {
+		if value < 0 {
+			base[y][x].SetInt64(int64(value))
+		} else {
+			base[y][x].SetUint64(uint64(value))
+		}
+	})
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = mulBig(result, base)
+		}
+		if n > 1 {
+			base = mulBig(base, base)
+		}
+	}
+	return result, nil
+}
+
+func newBigMatrix(n int) [][]*big.Int {
+	result := make([][]*big.Int, n)
+	for y := range result {
+		result[y] = make([]*big.Int, n)
+		for x := range result[y] {
+			result[y][x] = new(big.Int)
+		}
+	}
+	return result
+}
+
+func mulBig(a, b [][]*big.Int) [][]*big.Int {
+	result := newBigMatrix(len(a))
+	term := new(big.Int)
+	for y, row := range a {
+		for k, val := range row {
+			if val.Sign() == 0 {
+				continue
+			}
+			for x, other := range b[k] {
+				result[y][x].Add(result[y][x], term.Mul(val, other))
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package matrices
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestMul(t *testing.T) {
+	a := NewIntMatrixFromData([][]int{{1, 2, 3}, {4, 5, 6}})
+	b := NewIntMatrixFromData([][]int{{7, 8}, {9, 10}, {11, 12}})
+	got, err := a.Mul(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{58, 64}, {139, 154}}; !reflect.DeepEqual(got.data, want) {
+		t.Errorf("Mul() = %v, want %v", got.data, want)
+	}
+	if got, _ := a.Mul(NewIdentity[int](3)); !reflect.DeepEqual(got, a) {
+		t.Errorf("Mul() by the identity = %v", got.data)
+	}
+	sum, _ := a.Add(a)
+	if diff, _ := sum.Sub(a); !reflect.DeepEqual(diff, a) || !reflect.DeepEqual(sum, a.Scale(2)) {
+		t.Errorf("Add/Sub/Scale disagree: %v", sum.data)
+	}
+	if sq, _ := a.Hadamard(a); sq.data[1][2] != 36 {
+		t.Errorf("Hadamard() = %v", sq.data)
+	}
+	if tr := a.Transpose(); !reflect.DeepEqual(tr.data, [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Errorf("Transpose() = %v", tr.data)
+	}
+}
+
+func TestDimensionMismatch(t *testing.T) {
+	a := NewIntMatrixFromData([][]int{{1, 2, 3}, {4, 5, 6}})
+	b := NewIntMatrixFromData([][]int{{1, 2}, {3, 4}})
+	var errs []error
+	_, err := a.Add(b)
+	errs = append(errs, err)
+	_, err = a.Sub(b)
+	errs = append(errs, err)
+	_, err = a.Hadamard(b)
+	errs = append(errs, err)
+	_, err = a.Mul(b)
+	errs = append(errs, err)
+	_, err = a.MulMod(b, 7)
+	errs = append(errs, err)
+	_, err = a.Pow(2)
+	errs = append(errs, err)
+	_, err = a.PowMod(2, 7)
+	errs = append(errs, err)
+	_, err = a.PowBig(2)
+	errs = append(errs, err)
+	_, err = IntMatrix[int]{}.Pow(3)
+	errs = append(errs, err)
+	_, err = IntMatrix[int]{}.PowBig(3)
+	errs = append(errs, err)
+	for i, err := range errs {
+		if !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("check %d: error = %v, want ErrDimensionMismatch", i, err)
+		}
+	}
+	if _, err := b.Mul(a); err != nil {
+		t.Errorf("Mul() of 2x2 and 2x3 = %v", err)
+	}
+}
+
+func TestIdentityPanicsForEmpty(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "identity matrix size must be positive, got 0" {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	NewIdentity[int](0)
+}
+
+func TestPowersAgree(t *testing.T) {
+	rng := rand.New(rand.NewPCG(31, 32))
+	const mod = 1_000_000_007
+	for range 20 {
+		n := 1 + rng.IntN(4)
+		data := make([][]int64, n)
+		for y := range data {
+			data[y] = make([]int64, n)
+			for x := range data[y] {
+				data[y][x] = rng.Int64N(7) - 3
+			}
+		}
+		m := NewIntMatrixFromData(data)
+		for _, power := range []uint64{0, 1, 2, 5, 13} {
+			repeated := NewIdentity[int64](n)
+			for range power {
+				repeated, _ = repeated.Mul(m)
+			}
+			fast, _ := m.Pow(power)
+			if !reflect.DeepEqual(fast, repeated) {
+				t.Fatalf("Pow(%d) = %v, want %v", power, fast.data, repeated.data)
+			}
+			exact, _ := m.PowBig(power)
+			modular, _ := m.PowMod(power, mod)
+			for y := range exact {
+				for x, val := range exact[y] {
+					if val.Cmp(big.NewInt(fast.data[y][x])) != 0 {
+						t.Fatalf("PowBig(%d)[%d][%d] = %v, Pow gives %d", power, y, x, val, fast.data[y][x])
+					}
+					if want := new(big.Int).Mod(val, big.NewInt(mod)).Int64(); modular.data[y][x] != want {
+						t.Fatalf("PowMod(%d)[%d][%d] = %d, want %d", power, y, x, modular.data[y][x], want)
+					}
+				}
+			}
+		}
+		// Large powers overflow int64 but the modular and exact results must still agree
+		exact, _ := m.PowBig(200)
+		modular, _ := m.PowMod(200, mod)
+		for y := range exact {
+			for x, val := range exact[y] {
+				if want := new(big.Int).Mod(val, big.NewInt(mod)).Int64(); modular.data[y][x] != want {
+					t.Fatalf("PowMod(200)[%d][%d] = %d, want %d", y, x, modular.data[y][x], want)
+				}
+			}
+		}
+	}
+}
+
+func TestMulModNearOverflow(t *testing.T) {
+	const mod = math.MaxInt64
+	a := NewIntMatrixFromData([][]int64{{mod - 1, mod - 2}, {-1, 1 << 62}})
+	got, err := a.MulMod(a, mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bigMod := big.NewInt(mod)
+	for y := range 2 {
+		for x := range 2 {
+			want := new(big.Int)
+			for k := range 2 {
+				want.Add(want, new(big.Int).Mul(big.NewInt(a.data[y][k]), big.NewInt(a.data[k][x])))
+			}
+			want.Mod(want, bigMod)
+			if got.data[y][x] != want.Int64() {
+				t.Errorf("MulMod()[%d][%d] = %d, want %v", y, x, got.data[y][x], want)
+			}
+		}
+	}
+	u := NewIntMatrixFromData([][]uint64{{math.MaxUint64, math.MaxUint64 - 1}})
+	v := NewIntMatrixFromData([][]uint64{{math.MaxUint64}, {math.MaxUint64}})
+	umod := uint64(math.MaxUint64 - 58) //the entries reduce to 58 and 57, so the result is 58*58 + 57*58
+	if got, _ := u.MulMod(v, umod); got.data[0][0] != 6670 {
+		t.Errorf("MulMod() of uint64 = %d, want 6670", got.data[0][0])
+	}
+}
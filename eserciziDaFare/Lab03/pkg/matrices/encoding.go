@@ -0,0 +1,72 @@
+package matrices
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+)
+
+// ErrMismatchedRows is returned when decoding a matrix whose rows are not all the same length
+var ErrMismatchedRows = errors.New("unable to create matrix, mismatching row lengths")
+
+// matrixData is the exported form of a matrix used by the encoders
+type matrixData[T any] struct {
+	Rows    int   `json:"rows"`
+	Columns int   `json:"columns"`
+	Data    [][]T `json:"data"`
+}
+
+func (m Matrix[T]) export() matrixData[T] {
+	return matrixData[T]{Rows: m.Rows, Columns: m.Columns, Data: m.data}
+}
+
+func (m *Matrix[T]) load(d matrixData[T]) error {
+	if len(d.Data) != d.Rows {
+		return ErrMismatchedRows
+	}
+	for _, row := range d.Data {
+		if len(row) != d.Columns {
+			return ErrMismatchedRows
+		}
+	}
+	*m = Matrix[T]{
+		data:    d.Data,
+		Rows:    d.Rows,
+		Columns: d.Columns,
+		Size:    d.Rows * d.Columns,
+	}
+	return nil
+}
+
+// MarshalJSON encodes the matrix dimensions and its rows of data
+func (m Matrix[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.export())
+}
+
+// UnmarshalJSON decodes a matrix produced by MarshalJSON
+func (m *Matrix[T]) UnmarshalJSON(data []byte) error {
+	var d matrixData[T]
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	return m.load(d)
+}
+
+// MarshalBinary encodes the matrix using gob, this is also used when the matrix is gob encoded
+func (m Matrix[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(m.export()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a matrix produced by MarshalBinary
+func (m *Matrix[T]) UnmarshalBinary(data []byte) error {
+	var d matrixData[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&d); err != nil {
+		return err
+	}
+	return m.load(d)
+}
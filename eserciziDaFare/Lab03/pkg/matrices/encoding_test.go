@@ -0,0 +1,77 @@
+package matrices
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMatrixJSONRoundTrip(t *testing.T) {
+	m := NewMatrixFromData([][]string{{"a", "b", "c"}, {"d", "e", "f"}})
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"rows":2,"columns":3,"data":[["a","b","c"],["d","e","f"]]}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+	var decoded Matrix[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, m) {
+		t.Errorf("JSON round trip = %+v, want %+v", decoded, m)
+	}
+}
+
+func TestIntMatrixRoundTrip(t *testing.T) {
+	m := NewIntMatrixFromData([][]int{{1, 1, 6}, {1, 3, 8}, {2, 1, 3}, {3, 6, 9}})
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON IntMatrix[int]
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromJSON, m) {
+		t.Errorf("JSON round trip = %+v, want %+v", fromJSON, m)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(m); err != nil {
+		t.Fatal(err)
+	}
+	var fromGob IntMatrix[int]
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromGob, m) {
+		t.Errorf("gob round trip = %+v, want %+v", fromGob, m)
+	}
+}
+
+func TestMatrixDecodeMismatchedRows(t *testing.T) {
+	var m Matrix[int]
+	for _, data := range []string{
+		`{"rows":2,"columns":2,"data":[[1,2],[3]]}`,
+		`{"rows":3,"columns":2,"data":[[1,2],[3,4]]}`,
+		`{"rows":1,"columns":3,"data":[[1,2]]}`,
+	} {
+		if err := json.Unmarshal([]byte(data), &m); !errors.Is(err, ErrMismatchedRows) {
+			t.Errorf("json.Unmarshal(%s) = %v, want ErrMismatchedRows", data, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(matrixData[int]{Rows: 2, Columns: 1, Data: [][]int{{1}, {2, 3}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.UnmarshalBinary(buf.Bytes()); !errors.Is(err, ErrMismatchedRows) {
+		t.Errorf("UnmarshalBinary() = %v, want ErrMismatchedRows", err)
+	}
+}
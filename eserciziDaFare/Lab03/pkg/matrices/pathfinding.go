@@ -0,0 +1,207 @@
+package matrices
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// Point is a location in a matrix
+type Point struct{ X, Y int }
+
+// Unreachable is the distance recorded for locations that cannot be reached
+const Unreachable = -1
+
+// PathResult holds the shortest distance from a start location to every location in a matrix
+type PathResult struct {
+	// Distances holds the distance to each location, or Unreachable
+	Distances Matrix[int]
+	previous  []int // index of the location before each one on its shortest path, or -1
+}
+
+func newPathResult(rows, columns int) PathResult {
+	r := PathResult{Distances: NewMatrix[int](rows, columns), previous: make([]int, rows*columns)}
+	for y := range r.Distances.data {
+		for x := range r.Distances.data[y] {
+			r.Distances.data[y][x] = Unreachable
+		}
+	}
+	for i := range r.previous {
+		r.previous[i] = -1
+	}
+	return r
+}
+
+// Distance returns the distance to the location, or Unreachable
+func (r PathResult) Distance(x, y int) int {
+	return r.Distances.data[y][x]
+}
+
+// Path returns the locations on a shortest path from the start to (x, y) inclusive, nil if it is unreachable
+func (r PathResult) Path(x, y int) []Point {
+	if r.Distance(x, y) == Unreachable {
+		return nil
+	}
+	columns := r.Distances.Columns
+	path := []Point{}
+	for i := y*columns + x; i != -1; i = r.previous[i] {
+		path = append(path, Point{X: i % columns, Y: i / columns})
+	}
+	for lo, hi := 0, len(path)-1; lo < hi; lo, hi = lo+1, hi-1 {
+		path[lo], path[hi] = path[hi], path[lo]
+	}
+	return path
+}
+
+// BFS finds the fewest steps from the start to every location, moving only onto locations that are passable
+func (m Matrix[T]) BFS(startX, startY int, includeDiags bool, passable func(x, y int, value T) bool) PathResult {
+	result := newPathResult(m.Rows, m.Columns)
+	result.Distances.data[startY][startX] = 0
+	queue := []Point{{X: startX, Y: startY}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		m.ForEachNeighbour(includeDiags, p.X, p.Y, func(x, y int) {
+			if result.Distances.data[y][x] != Unreachable || !passable(x, y, m.data[y][x]) {
+				return
+			}
+			result.Distances.data[y][x] = result.Distances.data[p.Y][p.X] + 1
+			result.previous[y*m.Columns+x] = p.Y*m.Columns + p.X
+			queue = append(queue, Point{X: x, Y: y})
+		})
+	}
+	return result
+}
+
+// FloodFill marks every location connected to the start through passable locations, including the start
+func (m Matrix[T]) FloodFill(startX, startY int, includeDiags bool, passable func(x, y int, value T) bool) Matrix[bool] {
+	filled := NewMatrix[bool](m.Rows, m.Columns)
+	distances := m.BFS(startX, startY, includeDiags, passable).Distances
+	distances.ForEach(func(x, y int, dist int) {
+		filled.data[y][x] = dist != Unreachable
+	})
+	return filled
+}
+
+// Label numbers the connected regions of passable locations from 0, returning the label of each
+// location (Unreachable for locations that are not passable) and the number of regions
+func (m Matrix[T]) Label(includeDiags bool, passable func(x, y int, value T) bool) (Matrix[int], int) {
+	labels := NewMatrix[int](m.Rows, m.Columns)
+	for y := range labels.data {
+		for x := range labels.data[y] {
+			labels.data[y][x] = Unreachable
+		}
+	}
+	count := 0
+	m.ForEach(func(x, y int, value T) {
+		if labels.data[y][x] != Unreachable || !passable(x, y, value) {
+			return
+		}
+		stack := []Point{{X: x, Y: y}}
+		labels.data[y][x] = count
+		for len(stack) > 0 {
+			p := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			m.ForEachNeighbour(includeDiags, p.X, p.Y, func(i, j int) {
+				if labels.data[j][i] == Unreachable && passable(i, j, m.data[j][i]) {
+					labels.data[j][i] = count
+					stack = append(stack, Point{X: i, Y: j})
+				}
+			})
+		}
+		count++
+	})
+	return labels, count
+}
+
+// queueItem is a location waiting in the priority queue, ordered by priority
+type queueItem struct {
+	index    int
+	priority int
+}
+
+type priorityQueue []queueItem
+
+func (q priorityQueue) Len() int           { return len(q) }
+func (q priorityQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q priorityQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *priorityQueue) Push(x any)        { *q = append(*q, x.(queueItem)) }
+func (q *priorityQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// search runs Dijkstra's algorithm from the start, where entering a location costs its value.
+// The heuristic estimates the remaining cost from a location (zero for plain Dijkstra) and the
+// search stops early once the goal index is settled (-1 searches everything)
+func (m IntMatrix[T]) search(startX, startY int, includeDiags bool, goal int, heuristic func(x, y int) int) PathResult {
+	m.ForEach(func(x, y int, value T) {
+		if value < 0 {
+			panic(fmt.Sprintf("unable to find paths, negative cost %d at (%d, %d)", value, x, y))
+		}
+	})
+	result := newPathResult(m.Rows, m.Columns)
+	settled := make([]bool, m.Size)
+	start := startY*m.Columns + startX
+	result.Distances.data[startY][startX] = 0
+	queue := &priorityQueue{{index: start, priority: heuristic(startX, startY)}}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(queueItem).index
+		if settled[current] {
+			continue
+		}
+		settled[current] = true
+		if current == goal {
+			break
+		}
+		cx, cy := current%m.Columns, current/m.Columns
+		m.ForEachNeighbour(includeDiags, cx, cy, func(x, y int) {
+			next := y*m.Columns + x
+			dist := result.Distances.data[cy][cx] + int(m.data[y][x])
+			if settled[next] || (result.Distances.data[y][x] != Unreachable && dist >= result.Distances.data[y][x]) {
+				return
+			}
+			result.Distances.data[y][x] = dist
+			result.previous[next] = current
+			heap.Push(queue, queueItem{index: next, priority: dist + heuristic(x, y)})
+		})
+	}
+	return result
+}
+
+// Dijkstra finds the cheapest path from the start to every location, where entering a location
+// costs its value (so the start itself is free). Values must not be negative
+func (m IntMatrix[T]) Dijkstra(startX, startY int, includeDiags bool) PathResult {
+	return m.search(startX, startY, includeDiags, -1, func(x, y int) int { return 0 })
+}
+
+// AStar finds the cheapest path from the start to the goal, where entering a location costs its value,
+// guided by the Manhattan distance (Chebyshev with diagonals) scaled by the cheapest value. It returns
+// the path, its cost and false if the goal cannot be reached. Values must not be negative
+func (m IntMatrix[T]) AStar(startX, startY, goalX, goalY int, includeDiags bool) ([]Point, int, bool) {
+	minCost := int(m.data[0][0])
+	m.ForEach(func(x, y int, value T) {
+		minCost = min(minCost, int(value))
+	})
+	heuristic := func(x, y int) int {
+		dx, dy := abs(goalX-x), abs(goalY-y)
+		if includeDiags {
+			return minCost * max(dx, dy)
+		}
+		return minCost * (dx + dy)
+	}
+	result := m.search(startX, startY, includeDiags, goalY*m.Columns+goalX, heuristic)
+	cost := result.Distance(goalX, goalY)
+	if cost == Unreachable {
+		return nil, 0, false
+	}
+	return result.Path(goalX, goalY), cost, true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,146 @@
+package matrices
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+var riskExample = [][]int{
+	{1, 1, 6, 3, 7, 5, 1, 7, 4, 2},
+	{1, 3, 8, 1, 3, 7, 3, 6, 7, 2},
+	{2, 1, 3, 6, 5, 1, 1, 3, 2, 8},
+	{3, 6, 9, 4, 9, 3, 1, 5, 6, 9},
+	{7, 4, 6, 3, 4, 1, 7, 1, 1, 1},
+	{1, 3, 1, 9, 1, 2, 8, 1, 3, 7},
+	{1, 3, 5, 9, 9, 1, 2, 4, 2, 1},
+	{3, 1, 2, 5, 4, 2, 1, 6, 3, 9},
+	{1, 2, 9, 3, 1, 3, 8, 5, 2, 1},
+	{2, 3, 1, 1, 9, 4, 4, 5, 8, 1},
+}
+
+// pathCost sums the cost of entering every location on the path after the start
+func pathCost(m IntMatrix[int], path []Point) int {
+	cost := 0
+	for _, p := range path[1:] {
+		cost += m.data[p.Y][p.X]
+	}
+	return cost
+}
+
+// checkSteps fails unless consecutive locations on the path are neighbours
+func checkSteps(t *testing.T, path []Point, includeDiags bool) {
+	t.Helper()
+	for i := 1; i < len(path); i++ {
+		dx, dy := abs(path[i].X-path[i-1].X), abs(path[i].Y-path[i-1].Y)
+		if max(dx, dy) != 1 || (!includeDiags && dx+dy != 1) {
+			t.Fatalf("step from %v to %v is not a move to a neighbour", path[i-1], path[i])
+		}
+	}
+}
+
+func TestRiskExample(t *testing.T) {
+	m := NewIntMatrixFromData(riskExample)
+	goalX, goalY := m.Columns-1, m.Rows-1
+	path, cost, ok := m.AStar(0, 0, goalX, goalY, false)
+	if !ok || cost != 40 {
+		t.Fatalf("AStar() = %d, %v, want 40", cost, ok)
+	}
+	if path[0] != (Point{0, 0}) || path[len(path)-1] != (Point{goalX, goalY}) || pathCost(m, path) != 40 {
+		t.Errorf("AStar() path %v does not cost 40", path)
+	}
+	checkSteps(t, path, false)
+	if got := m.Dijkstra(0, 0, false).Distance(goalX, goalY); got != 40 {
+		t.Errorf("Dijkstra() = %d, want 40", got)
+	}
+}
+
+func TestAStarMatchesDijkstra(t *testing.T) {
+	rng := rand.New(rand.NewPCG(19, 20))
+	for round := range 50 {
+		rows, columns := 1+rng.IntN(15), 1+rng.IntN(15)
+		data := make([][]int, rows)
+		for y := range data {
+			data[y] = make([]int, columns)
+			for x := range data[y] {
+				data[y][x] = rng.IntN(10)
+			}
+		}
+		m := NewIntMatrixFromData(data)
+		includeDiags := round%2 == 1
+		startX, startY := rng.IntN(columns), rng.IntN(rows)
+		goalX, goalY := rng.IntN(columns), rng.IntN(rows)
+		distances := m.Dijkstra(startX, startY, includeDiags)
+		path, cost, ok := m.AStar(startX, startY, goalX, goalY, includeDiags)
+		if !ok || cost != distances.Distance(goalX, goalY) {
+			t.Fatalf("AStar() = %d, %v, Dijkstra() = %d", cost, ok, distances.Distance(goalX, goalY))
+		}
+		checkSteps(t, path, includeDiags)
+		if got := pathCost(m, path); got != cost {
+			t.Fatalf("AStar() path costs %d, reported %d", got, cost)
+		}
+		if got := pathCost(m, distances.Path(goalX, goalY)); got != cost {
+			t.Fatalf("Dijkstra() path costs %d, distance %d", got, cost)
+		}
+	}
+}
+
+func TestBFS(t *testing.T) {
+	maze := NewMatrixFromData([][]rune{
+		[]rune("..#...."),
+		[]rune(".##.##."),
+		[]rune("....#.."),
+		[]rune("#####.#"),
+		[]rune("...#..."),
+	})
+	open := func(x, y int, value rune) bool { return value == '.' }
+	result := maze.BFS(0, 0, false, open)
+	if got := result.Distance(6, 4); got != 16 {
+		t.Errorf("Distance(6, 4) = %d, want 16", got)
+	}
+	path := result.Path(6, 4)
+	if len(path) != 17 {
+		t.Errorf("Path(6, 4) has %d locations, want 17", len(path))
+	}
+	checkSteps(t, path, false)
+	if result.Distance(0, 4) != Unreachable || result.Path(0, 4) != nil {
+		t.Errorf("walled off location reached in %d steps", result.Distance(0, 4))
+	}
+	if got := maze.BFS(0, 0, true, open).Distance(6, 4); got != 10 {
+		t.Errorf("Distance(6, 4) with diagonals = %d, want 10", got)
+	}
+	filled := maze.FloodFill(0, 0, false, open)
+	if !filled.data[4][6] || filled.data[4][0] || filled.data[0][2] {
+		t.Error("FloodFill() marked the wrong locations")
+	}
+}
+
+func TestLabel(t *testing.T) {
+	heights := NewIntMatrixFromData([][]int{
+		{2, 1, 9, 9, 9, 4, 3, 2, 1, 0},
+		{3, 9, 8, 7, 8, 9, 4, 9, 2, 1},
+		{9, 8, 5, 6, 7, 8, 9, 8, 9, 2},
+		{8, 7, 6, 7, 8, 9, 6, 7, 8, 9},
+		{9, 8, 9, 9, 9, 6, 5, 6, 7, 8},
+	})
+	basin := func(x, y int, value int) bool { return value != 9 }
+	labels, count := heights.Label(false, basin)
+	if count != 4 {
+		t.Fatalf("Label() found %d basins, want 4", count)
+	}
+	sizes := make([]int, count)
+	labels.ForEach(func(x, y int, label int) {
+		if label != Unreachable {
+			sizes[label]++
+		}
+	})
+	if !reflect.DeepEqual(sizes, []int{3, 9, 14, 9}) {
+		t.Errorf("basin sizes = %v, want [3 9 14 9]", sizes)
+	}
+	if labels.data[0][2] != Unreachable {
+		t.Errorf("wall labelled %d", labels.data[0][2])
+	}
+	if _, count := heights.Label(true, basin); count != 1 {
+		t.Errorf("Label() with diagonals found %d basins, want 1", count)
+	}
+}
@@ -0,0 +1,142 @@
+package matrices
+
+import "fmt"
+
+// Axis identifies the direction of a fold line
+type Axis int
+
+const (
+	// AxisX is a vertical line x = n, folding the right part over to the left
+	AxisX Axis = iota
+	// AxisY is a horizontal line y = n, folding the bottom part up over the top
+	AxisY
+)
+
+// Transpose returns the matrix with its rows and columns swapped
+func (m Matrix[T]) Transpose() Matrix[T] {
+	result := NewMatrix[T](m.Columns, m.Rows)
+	m.ForEach(func(x, y int, value T) {
+		result.data[x][y] = value
+	})
+	return result
+}
+
+// Rotate90 returns the matrix rotated a quarter turn clockwise
+func (m Matrix[T]) Rotate90() Matrix[T] {
+	result := NewMatrix[T](m.Columns, m.Rows)
+	m.ForEach(func(x, y int, value T) {
+		result.data[x][m.Rows-1-y] = value
+	})
+	return result
+}
+
+// Rotate180 returns the matrix rotated a half turn
+func (m Matrix[T]) Rotate180() Matrix[T] {
+	result := NewMatrix[T](m.Rows, m.Columns)
+	m.ForEach(func(x, y int, value T) {
+		result.data[m.Rows-1-y][m.Columns-1-x] = value
+	})
+	return result
+}
+
+// Rotate270 returns the matrix rotated a quarter turn anticlockwise
+func (m Matrix[T]) Rotate270() Matrix[T] {
+	result := NewMatrix[T](m.Columns, m.Rows)
+	m.ForEach(func(x, y int, value T) {
+		result.data[m.Columns-1-x][y] = value
+	})
+	return result
+}
+
+// FlipHorizontal returns the matrix mirrored left to right
+func (m Matrix[T]) FlipHorizontal() Matrix[T] {
+	result := NewMatrix[T](m.Rows, m.Columns)
+	m.ForEach(func(x, y int, value T) {
+		result.data[y][m.Columns-1-x] = value
+	})
+	return result
+}
+
+// FlipVertical returns the matrix mirrored top to bottom
+func (m Matrix[T]) FlipVertical() Matrix[T] {
+	result := NewMatrix[T](m.Rows, m.Columns)
+	m.ForEach(func(x, y int, value T) {
+		result.data[m.Rows-1-y][x] = value
+	})
+	return result
+}
+
+// SubMatrix returns a copy of the region with its top left corner at (x, y) and the provided size
+func (m Matrix[T]) SubMatrix(x, y, columns, rows int) Matrix[T] {
+	if columns <= 0 || rows <= 0 || m.OutOfBounds(x, y) || m.OutOfBounds(x+columns-1, y+rows-1) {
+		panic(fmt.Sprintf("unable to crop %dx%d at (%d, %d) from %dx%d matrix", columns, rows, x, y, m.Columns, m.Rows))
+	}
+	result := NewMatrix[T](rows, columns)
+	for j := 0; j < rows; j++ {
+		copy(result.data[j], m.data[y+j][x:x+columns])
+	}
+	return result
+}
+
+// Tile returns a matrix made of the matrix repeated across by down times. The transform (if not nil)
+// is applied to each copy given its tile position, and must keep the dimensions of the matrix
+func (m Matrix[T]) Tile(across, down int, transform func(tileX, tileY int, tile Matrix[T]) Matrix[T]) Matrix[T] {
+	if across <= 0 || down <= 0 {
+		panic(fmt.Sprintf("unable to tile matrix %d across by %d down", across, down))
+	}
+	result := NewMatrix[T](m.Rows*down, m.Columns*across)
+	for tileY := 0; tileY < down; tileY++ {
+		for tileX := 0; tileX < across; tileX++ {
+			tile := m
+			if transform != nil {
+				tile = transform(tileX, tileY, m)
+			}
+			if tile.Rows != m.Rows || tile.Columns != m.Columns {
+				panic("unable to tile matrix, transform changed the dimensions")
+			}
+			tile.ForEach(func(x, y int, value T) {
+				result.data[tileY*m.Rows+y][tileX*m.Columns+x] = value
+			})
+		}
+	}
+	return result
+}
+
+// Fold returns the matrix folded along the line, discarding the line itself. Entries that land on
+// each other are combined with merge(kept, folded), for example an or for a Matrix[bool] of dots.
+// If the folded part is the larger, the result grows to fit it
+func (m Matrix[T]) Fold(axis Axis, line int, merge func(kept, folded T) T) Matrix[T] {
+	if axis == AxisX {
+		if line < 0 || line >= m.Columns {
+			panic(fmt.Sprintf("fold line x=%d out of range for %d columns", line, m.Columns))
+		}
+		return m.Transpose().Fold(AxisY, line, merge).Transpose()
+	}
+	if line < 0 || line >= m.Rows {
+		panic(fmt.Sprintf("fold line y=%d out of range for %d rows", line, m.Rows))
+	}
+	size := max(line, m.Rows-1-line)
+	if size == 0 {
+		panic("unable to fold a matrix along its only row or column")
+	}
+	// Both parts are aligned so that they meet at the bottom of the result
+	offset := size - line
+	result := NewMatrix[T](size, m.Columns)
+	filled := make([]bool, size)
+	for y := 0; y < line; y++ {
+		copy(result.data[y+offset], m.data[y])
+		filled[y+offset] = true
+	}
+	for y := line + 1; y < m.Rows; y++ {
+		target := 2*line - y + offset
+		if !filled[target] {
+			copy(result.data[target], m.data[y])
+			filled[target] = true
+			continue
+		}
+		for x, value := range m.data[y] {
+			result.data[target][x] = merge(result.data[target][x], value)
+		}
+	}
+	return result
+}
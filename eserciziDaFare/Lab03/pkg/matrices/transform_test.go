@@ -0,0 +1,153 @@
+package matrices
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var grid = NewMatrixFromData([][]int{
+	{1, 2, 3},
+	{4, 5, 6},
+})
+
+func TestRotateFlipTranspose(t *testing.T) {
+	cases := map[string]struct {
+		got  Matrix[int]
+		want [][]int
+	}{
+		"Transpose":      {grid.Transpose(), [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		"Rotate90":       {grid.Rotate90(), [][]int{{4, 1}, {5, 2}, {6, 3}}},
+		"Rotate180":      {grid.Rotate180(), [][]int{{6, 5, 4}, {3, 2, 1}}},
+		"Rotate270":      {grid.Rotate270(), [][]int{{3, 6}, {2, 5}, {1, 4}}},
+		"FlipHorizontal": {grid.FlipHorizontal(), [][]int{{3, 2, 1}, {6, 5, 4}}},
+		"FlipVertical":   {grid.FlipVertical(), [][]int{{4, 5, 6}, {1, 2, 3}}},
+	}
+	for name, c := range cases {
+		if !reflect.DeepEqual(c.got, NewMatrixFromData(c.want)) {
+			t.Errorf("%s() = %v, want %v", name, c.got.data, c.want)
+		}
+	}
+	// Four quarter turns, or a rotation and its inverse, give back the original
+	if r := grid.Rotate90().Rotate90().Rotate90().Rotate90(); !reflect.DeepEqual(r, grid) {
+		t.Errorf("four Rotate90() = %v", r.data)
+	}
+	if r := grid.Rotate90().Rotate270(); !reflect.DeepEqual(r, grid) {
+		t.Errorf("Rotate90().Rotate270() = %v", r.data)
+	}
+	if r := grid.Rotate90(); !reflect.DeepEqual(r, grid.Transpose().FlipHorizontal()) {
+		t.Errorf("Rotate90() differs from a transpose and flip: %v", r.data)
+	}
+	if r := grid.Rotate180(); !reflect.DeepEqual(r, grid.FlipHorizontal().FlipVertical()) {
+		t.Errorf("Rotate180() differs from both flips: %v", r.data)
+	}
+}
+
+func TestSubMatrix(t *testing.T) {
+	got := grid.SubMatrix(1, 0, 2, 2)
+	if !reflect.DeepEqual(got, NewMatrixFromData([][]int{{2, 3}, {5, 6}})) {
+		t.Errorf("SubMatrix() = %v", got.data)
+	}
+	got.data[0][0] = 99
+	if grid.data[0][1] != 2 {
+		t.Error("SubMatrix() shares data with the original")
+	}
+	expectPanic(t, "unable to crop", func() { grid.SubMatrix(2, 0, 2, 1) })
+	expectPanic(t, "unable to crop", func() { grid.SubMatrix(0, 0, 0, 1) })
+}
+
+func TestTile(t *testing.T) {
+	risk := NewMatrixFromData([][]int{{8}})
+	// The AoC day 15 expansion adds the tile distance to each value, wrapping 9 back to 1
+	got := risk.Tile(5, 5, func(tileX, tileY int, tile Matrix[int]) Matrix[int] {
+		result := NewMatrix[int](tile.Rows, tile.Columns)
+		tile.ForEach(func(x, y int, value int) {
+			result.data[y][x] = (value+tileX+tileY-1)%9 + 1
+		})
+		return result
+	})
+	want := [][]int{
+		{8, 9, 1, 2, 3},
+		{9, 1, 2, 3, 4},
+		{1, 2, 3, 4, 5},
+		{2, 3, 4, 5, 6},
+		{3, 4, 5, 6, 7},
+	}
+	if !reflect.DeepEqual(got.data, want) {
+		t.Errorf("Tile() = %v, want %v", got.data, want)
+	}
+	if plain := grid.Tile(2, 1, nil); !reflect.DeepEqual(plain.data, [][]int{{1, 2, 3, 1, 2, 3}, {4, 5, 6, 4, 5, 6}}) {
+		t.Errorf("Tile() without a transform = %v", plain.data)
+	}
+	expectPanic(t, "unable to tile matrix 0 across by 2 down", func() { grid.Tile(0, 2, nil) })
+	expectPanic(t, "unable to tile matrix 1 across by -1 down", func() { grid.Tile(1, -1, nil) })
+	expectPanic(t, "changed the dimensions", func() {
+		grid.Tile(2, 2, func(tileX, tileY int, tile Matrix[int]) Matrix[int] { return tile.Transpose() })
+	})
+}
+
+func TestFold(t *testing.T) {
+	sum := func(kept, folded int) int { return kept*10 + folded }
+	m := NewMatrixFromData([][]int{{1}, {2}, {3}, {4}, {5}, {6}, {7}})
+	// Equal halves: rows 0-2 meet rows 6-4
+	if got := m.Fold(AxisY, 3, sum); !reflect.DeepEqual(got.data, [][]int{{17}, {26}, {35}}) {
+		t.Errorf("Fold(y=3) = %v", got.data)
+	}
+	// Larger bottom part: rows 5 and 6 stick out beyond the top
+	if got := m.Fold(AxisY, 2, sum); !reflect.DeepEqual(got.data, [][]int{{7}, {6}, {15}, {24}}) {
+		t.Errorf("Fold(y=2) = %v", got.data)
+	}
+	// Larger top part: row 0 has nothing folded onto it
+	if got := m.Fold(AxisY, 5, sum); !reflect.DeepEqual(got.data, [][]int{{1}, {2}, {3}, {4}, {57}}) {
+		t.Errorf("Fold(y=5) = %v", got.data)
+	}
+	row := NewMatrixFromData([][]int{{1, 2, 3, 4, 5, 6}})
+	if got := row.Fold(AxisX, 2, sum); !reflect.DeepEqual(got.data, [][]int{{6, 15, 24}}) {
+		t.Errorf("Fold(x=2) = %v", got.data)
+	}
+	if got := row.Fold(AxisX, 4, sum); !reflect.DeepEqual(got.data, [][]int{{1, 2, 3, 46}}) {
+		t.Errorf("Fold(x=4) = %v", got.data)
+	}
+	expectPanic(t, "fold line x=6 out of range for 6 columns", func() { row.Fold(AxisX, 6, sum) })
+	expectPanic(t, "fold line y=-1 out of range", func() { m.Fold(AxisY, -1, sum) })
+	expectPanic(t, "only row or column", func() { row.Fold(AxisY, 0, sum) })
+}
+
+func TestFoldDots(t *testing.T) {
+	dots := [][2]int{{6, 10}, {0, 14}, {9, 10}, {0, 3}, {10, 4}, {4, 11}, {6, 0}, {6, 12}, {4, 1},
+		{0, 13}, {10, 12}, {3, 4}, {3, 0}, {8, 4}, {1, 10}, {2, 14}, {8, 10}, {9, 0}}
+	paper := NewMatrix[bool](15, 11)
+	for _, d := range dots {
+		paper.data[d[1]][d[0]] = true
+	}
+	or := func(kept, folded bool) bool { return kept || folded }
+	count := func(m Matrix[bool]) int {
+		total := 0
+		m.ForEach(func(x, y int, value bool) {
+			if value {
+				total++
+			}
+		})
+		return total
+	}
+	once := paper.Fold(AxisY, 7, or)
+	if count(once) != 17 {
+		t.Errorf("first fold leaves %d dots, want 17", count(once))
+	}
+	twice := once.Fold(AxisX, 5, or)
+	if count(twice) != 16 || twice.Rows != 7 || twice.Columns != 5 {
+		t.Errorf("second fold leaves %d dots in %dx%d", count(twice), twice.Columns, twice.Rows)
+	}
+}
+
+func expectPanic(t *testing.T, message string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, message) {
+			t.Errorf("panic %v, want one containing %q", r, message)
+		}
+	}()
+	f()
+}
@@ -0,0 +1,116 @@
+package memo
+
+import (
+	"adventofcode2021/pkg/cache"
+	"fmt"
+)
+
+// store holds computed results, either an unbounded map or a bounded cache
+type store[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Len() int
+}
+
+type mapStore[K comparable, V any] map[K]V
+
+func (m mapStore[K, V]) Get(key K) (V, bool) {
+	val, ok := m[key]
+	return val, ok
+}
+
+func (m mapStore[K, V]) Put(key K, value V) {
+	m[key] = value
+}
+
+func (m mapStore[K, V]) Len() int {
+	return len(m)
+}
+
+// Stats records how effective a memo has been
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+	Size      int
+}
+
+// HitRate returns the fraction of calls answered from the cache
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Memo caches the results of a function by its argument. Functions of several arguments can use a
+// tuples.Pair or tuples.Triple (or any comparable struct) as the key. A Memo is not safe for concurrent use
+type Memo[K comparable, V any] struct {
+	fn       func(self func(K) V, key K) V
+	capacity int // zero when unbounded
+	store    store[K, V]
+	stats    Stats
+}
+
+// New memoizes a function with an unbounded cache
+func New[K comparable, V any](fn func(K) V) *Memo[K, V] {
+	return NewRecursive(func(_ func(K) V, key K) V { return fn(key) })
+}
+
+// NewRecursive memoizes a recursive function with an unbounded cache. The function receives self,
+// which it must call instead of itself so that the recursive calls are memoized too
+func NewRecursive[K comparable, V any](fn func(self func(K) V, key K) V) *Memo[K, V] {
+	m := &Memo[K, V]{fn: fn}
+	m.Reset()
+	return m
+}
+
+// NewBounded memoizes a function, keeping only the capacity most recently used results
+func NewBounded[K comparable, V any](capacity int, fn func(K) V) *Memo[K, V] {
+	return NewBoundedRecursive(capacity, func(_ func(K) V, key K) V { return fn(key) })
+}
+
+// NewBoundedRecursive memoizes a recursive function, keeping only the capacity most recently used
+// results, see NewRecursive
+func NewBoundedRecursive[K comparable, V any](capacity int, fn func(self func(K) V, key K) V) *Memo[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("memo capacity must be positive, got %d", capacity))
+	}
+	m := &Memo[K, V]{fn: fn, capacity: capacity}
+	m.Reset()
+	return m
+}
+
+// Get returns the result of the function for key, computing it only if it is not cached
+func (m *Memo[K, V]) Get(key K) V {
+	if val, ok := m.store.Get(key); ok {
+		m.stats.Hits++
+		return val
+	}
+	m.stats.Misses++
+	val := m.fn(m.Get, key)
+	m.store.Put(key, val)
+	return val
+}
+
+// Func returns the memoized function
+func (m *Memo[K, V]) Func() func(K) V {
+	return m.Get
+}
+
+// Reset will discard every cached result and the statistics
+func (m *Memo[K, V]) Reset() {
+	if m.capacity > 0 {
+		m.store = cache.NewLRU(m.capacity, func(K, V) { m.stats.Evictions++ })
+	} else {
+		m.store = mapStore[K, V]{}
+	}
+	m.stats = Stats{}
+}
+
+// Stats returns the statistics gathered so far
+func (m *Memo[K, V]) Stats() Stats {
+	s := m.stats
+	s.Size = m.store.Len()
+	return s
+}
@@ -0,0 +1,90 @@
+package memo
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"testing"
+)
+
+func TestRecursiveCounts(t *testing.T) {
+	calls := 0
+	fib := NewRecursive(func(self func(int) int, n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return self(n-1) + self(n-2)
+	})
+	if got := fib.Get(50); got != 12586269025 {
+		t.Fatalf("fib(50) = %d", got)
+	}
+	// Every value 0..50 is computed once, and the second recursive call of 3..50 is a hit
+	want := Stats{Hits: 48, Misses: 51, Size: 51}
+	if s := fib.Stats(); s != want || calls != 51 {
+		t.Errorf("Stats() = %+v after %d calls, want %+v after 51", s, calls, want)
+	}
+	fib.Func()(50)
+	if s := fib.Stats(); s.Hits != 49 || s.Misses != 51 || calls != 51 {
+		t.Errorf("Stats() after a repeated call = %+v after %d calls", s, calls)
+	}
+	fib.Reset()
+	if s := fib.Stats(); s != (Stats{}) {
+		t.Errorf("Stats() after Reset() = %+v", s)
+	}
+	fib.Get(10)
+	if calls != 62 {
+		t.Errorf("Reset() kept cached results, %d calls", calls)
+	}
+}
+
+func TestTupleKeys(t *testing.T) {
+	calls := 0
+	paths := NewRecursive(func(self func(tuples.Pair[int, int]) int, p tuples.Pair[int, int]) int {
+		calls++
+		if p.Key == 0 || p.Value == 0 {
+			return 1
+		}
+		return self(tuples.NewPair(p.Key-1, p.Value)) + self(tuples.NewPair(p.Key, p.Value-1))
+	})
+	if got := paths.Get(tuples.NewPair(16, 16)); got != 601080390 {
+		t.Errorf("grid paths = %d, want 601080390", got)
+	}
+	if calls > 17*17 {
+		t.Errorf("%d calls, want at most one per grid point", calls)
+	}
+}
+
+func TestBoundedEviction(t *testing.T) {
+	calls := map[int]int{}
+	square := NewBounded(2, func(n int) int {
+		calls[n]++
+		return n * n
+	})
+	for _, n := range []int{1, 2, 1, 3, 2, 1} {
+		if got := square.Get(n); got != n*n {
+			t.Fatalf("square(%d) = %d", n, got)
+		}
+	}
+	// 3 evicts 2 as 1 was used more recently, then 2 evicts 1 and 1 evicts 3
+	want := Stats{Hits: 1, Misses: 5, Evictions: 3, Size: 2}
+	if s := square.Stats(); s != want {
+		t.Errorf("Stats() = %+v, want %+v", s, want)
+	}
+	if calls[1] != 2 || calls[2] != 2 || calls[3] != 1 {
+		t.Errorf("function calls = %v", calls)
+	}
+	if rate := square.Stats().HitRate(); rate != 1.0/6 {
+		t.Errorf("HitRate() = %v, want 1/6", rate)
+	}
+	if rate := (Stats{}).HitRate(); rate != 0 {
+		t.Errorf("HitRate() of no calls = %v", rate)
+	}
+}
+
+func TestBoundedCapacityPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NewBounded(0) did not panic")
+		}
+	}()
+	NewBounded(0, func(n int) int { return n })
+}
@@ -0,0 +1,156 @@
+package population
+
+import (
+	"adventofcode2021/pkg/matrices"
+	"fmt"
+	"math/big"
+)
+
+// Config describes how a population of timer based creatures (such as lanternfish) reproduces.
+// Every day each timer decreases by one, a creature whose timer is 0 resets to ResetTimer and
+// creates a newborn with NewbornTimer
+type Config struct {
+	ResetTimer   int
+	NewbornTimer int
+}
+
+// DefaultConfig is the lanternfish reproduction cycle
+func DefaultConfig() Config {
+	return Config{ResetTimer: 6, NewbornTimer: 8}
+}
+
+// Validate checks the timers describe a possible reproduction cycle
+func (c Config) Validate() error {
+	if c.ResetTimer < 0 || c.NewbornTimer < 0 {
+		return fmt.Errorf("timers must not be negative, got reset %d and newborn %d", c.ResetTimer, c.NewbornTimer)
+	}
+	if c.ResetTimer > c.NewbornTimer {
+		return fmt.Errorf("reset timer %d must not be greater than newborn timer %d", c.ResetTimer, c.NewbornTimer)
+	}
+	return nil
+}
+
+// States returns the number of distinct timer values
+func (c Config) States() int {
+	return c.NewbornTimer + 1
+}
+
+// TransitionMatrix returns the matrix that advances a column of counts per timer by one day
+func (c Config) TransitionMatrix() matrices.IntMatrix[int64] {
+	n := c.States()
+	m := matrices.NewIntMatrixFromBase(matrices.NewMatrix[int64](n, n))
+	for timer := 0; timer < n-1; timer++ {
+		m.Set(timer+1, timer, 1)
+	}
+	m.Increment(0, c.ResetTimer)
+	m.Increment(0, c.NewbornTimer)
+	return m
+}
+
+// Simulator tracks the number of creatures with each timer value, day by day
+type Simulator struct {
+	config Config
+	counts []*big.Int
+	day    int
+}
+
+// NewSimulator creates a simulator for the creatures with the provided starting timers
+func NewSimulator(config Config, timers []int) (*Simulator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	counts := make([]*big.Int, config.States())
+	for i := range counts {
+		counts[i] = new(big.Int)
+	}
+	one := big.NewInt(1)
+	for _, timer := range timers {
+		if timer < 0 || timer >= len(counts) {
+			return nil, fmt.Errorf("timer %d out of range [0, %d]", timer, config.NewbornTimer)
+		}
+		counts[timer].Add(counts[timer], one)
+	}
+	return &Simulator{config: config, counts: counts}, nil
+}
+
+// Step advances the simulation by one day
+func (s *Simulator) Step() {
+	spawning := s.counts[0]
+	copy(s.counts, s.counts[1:])
+	s.counts[len(s.counts)-1] = new(big.Int).Set(spawning)
+	s.counts[s.config.ResetTimer].Add(s.counts[s.config.ResetTimer], spawning)
+	s.day++
+}
+
+// Run advances the simulation by the number of days, calling trace (if not nil) with the state
+// before the first step and after every step. An error from trace stops the simulation
+func (s *Simulator) Run(days int, trace func(s *Simulator) error) error {
+	if trace != nil {
+		if err := trace(s); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < days; i++ {
+		s.Step()
+		if trace != nil {
+			if err := trace(s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Day returns the number of days simulated so far
+func (s *Simulator) Day() int {
+	return s.day
+}
+
+// Counts returns a copy of the number of creatures with each timer value
+func (s *Simulator) Counts() []*big.Int {
+	result := make([]*big.Int, len(s.counts))
+	for i, count := range s.counts {
+		result[i] = new(big.Int).Set(count)
+	}
+	return result
+}
+
+// Total returns the number of creatures
+func (s *Simulator) Total() *big.Int {
+	total := new(big.Int)
+	for _, count := range s.counts {
+		total.Add(total, count)
+	}
+	return total
+}
+
+// TotalAfter returns the exact number of creatures after a further number of days without stepping
+// the simulator, using exponentiation of the transition matrix in O(log days) multiplications
+func (s *Simulator) TotalAfter(days uint64) *big.Int {
+	power, _ := s.config.TransitionMatrix().PowBig(days)
+	total := new(big.Int)
+	term := new(big.Int)
+	for _, row := range power {
+		for timer, val := range row {
+			total.Add(total, term.Mul(val, s.counts[timer]))
+		}
+	}
+	return total
+}
+
+// TotalAfterMod returns the number of creatures after a further number of days modulo mod, which
+// stays cheap for astronomical day counts whose exact result would have too many digits
+func (s *Simulator) TotalAfterMod(days uint64, mod int64) int64 {
+	power, _ := s.config.TransitionMatrix().PowMod(days, mod)
+	state := make([][]int64, len(s.counts))
+	bigMod := big.NewInt(mod)
+	for timer, count := range s.counts {
+		state[timer] = []int64{new(big.Int).Mod(count, bigMod).Int64()}
+	}
+	final, _ := power.MulMod(matrices.NewIntMatrixFromData(state), mod)
+	var total int64
+	final.ForEach(func(x, y int, value int64) {
+		total = (total + value) % mod
+	})
+	return total
+}
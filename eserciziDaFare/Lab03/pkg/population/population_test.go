@@ -0,0 +1,71 @@
+package population
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+var example = []int{3, 4, 3, 1, 2}
+
+func TestKnownAnswers(t *testing.T) {
+	for days, want := range map[int]int64{18: 26, 80: 5934, 256: 26984457539} {
+		sim, err := NewSimulator(DefaultConfig(), example)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := sim.TotalAfter(uint64(days)); got.Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("TotalAfter(%d) = %v, want %d", days, got, want)
+		}
+		if err := sim.Run(days, nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := sim.Total(); got.Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("Run(%d) total = %v, want %d", days, got, want)
+		}
+		if sim.Day() != days {
+			t.Errorf("Day() = %d, want %d", sim.Day(), days)
+		}
+	}
+}
+
+func TestRunMatchesTotalAfter(t *testing.T) {
+	rng := rand.New(rand.NewPCG(21, 22))
+	configs := []Config{DefaultConfig(), {ResetTimer: 0, NewbornTimer: 0}, {ResetTimer: 2, NewbornTimer: 5}, {ResetTimer: 4, NewbornTimer: 4}}
+	const mod = 1_000_000_007
+	for _, config := range configs {
+		timers := make([]int, 20)
+		for i := range timers {
+			timers[i] = rng.IntN(config.States())
+		}
+		for _, days := range []int{0, 1, 7, 100, 300} {
+			sim, err := NewSimulator(config, timers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			exact := sim.TotalAfter(uint64(days))
+			modular := sim.TotalAfterMod(uint64(days), mod)
+			if want := new(big.Int).Mod(exact, big.NewInt(mod)).Int64(); modular != want {
+				t.Errorf("%+v: TotalAfterMod(%d) = %d, want %d", config, days, modular, want)
+			}
+			if err := sim.Run(days, nil); err != nil {
+				t.Fatal(err)
+			}
+			if got := sim.Total(); got.Cmp(exact) != 0 {
+				t.Errorf("%+v: Run(%d) total = %v, TotalAfter = %v", config, days, got, exact)
+			}
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	if _, err := NewSimulator(Config{ResetTimer: 7, NewbornTimer: 6}, example); err == nil {
+		t.Error("accepted a reset timer above the newborn timer")
+	}
+	if _, err := NewSimulator(Config{ResetTimer: -1, NewbornTimer: 6}, example); err == nil {
+		t.Error("accepted a negative timer")
+	}
+	if _, err := NewSimulator(DefaultConfig(), []int{3, 9}); err == nil {
+		t.Error("accepted a timer above the newborn timer")
+	}
+}
@@ -0,0 +1,55 @@
+package population
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+)
+
+// NewCSVTrace returns a trace function for Simulator.Run that writes a header and then one row per
+// day with the day, total and count for each timer value. The header is built from the first simulator
+// traced, so the trace must not be shared between simulators. The writer is flushed after every row
+func NewCSVTrace(w io.Writer) func(s *Simulator) error {
+	out := csv.NewWriter(w)
+	wroteHeader := false
+	return func(s *Simulator) error {
+		if !wroteHeader {
+			header := []string{"day", "total"}
+			for timer := range s.counts {
+				header = append(header, fmt.Sprintf("timer%d", timer))
+			}
+			if err := out.Write(header); err != nil {
+				return err
+			}
+			wroteHeader = true
+		}
+		row := []string{strconv.Itoa(s.Day()), s.Total().String()}
+		for _, count := range s.counts {
+			row = append(row, count.String())
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+		out.Flush()
+		return out.Error()
+	}
+}
+
+// traceEntry is a single day of a JSON trace
+type traceEntry struct {
+	Day    int        `json:"day"`
+	Total  *big.Int   `json:"total"`
+	Timers []*big.Int `json:"timers"`
+}
+
+// NewJSONTrace returns a trace function for Simulator.Run that writes one JSON object per line with
+// the day, total and count for each timer value
+func NewJSONTrace(w io.Writer) func(s *Simulator) error {
+	enc := json.NewEncoder(w)
+	return func(s *Simulator) error {
+		return enc.Encode(traceEntry{Day: s.Day(), Total: s.Total(), Timers: s.counts})
+	}
+}
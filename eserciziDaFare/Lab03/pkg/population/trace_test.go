@@ -0,0 +1,76 @@
+package population
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCSVTrace(t *testing.T) {
+	sim, err := NewSimulator(Config{ResetTimer: 1, NewbornTimer: 2}, []int{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := sim.Run(2, NewCSVTrace(&out)); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"day,total,timer0,timer1,timer2",
+		"0,2,1,0,1",
+		"1,3,0,2,1",
+		"2,3,2,1,0",
+		"",
+	}, "\n")
+	if out.String() != want {
+		t.Errorf("CSV trace =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestJSONTrace(t *testing.T) {
+	sim, err := NewSimulator(DefaultConfig(), example)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := sim.Run(18, NewJSONTrace(&out)); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 19 {
+		t.Fatalf("JSON trace has %d lines, want 19", len(lines))
+	}
+	var last struct {
+		Day    int   `json:"day"`
+		Total  int   `json:"total"`
+		Timers []int `json:"timers"`
+	}
+	if err := json.Unmarshal([]byte(lines[18]), &last); err != nil {
+		t.Fatal(err)
+	}
+	if last.Day != 18 || last.Total != 26 || len(last.Timers) != 9 {
+		t.Errorf("last JSON trace entry = %+v", last)
+	}
+	if lines[0] != `{"day":0,"total":5,"timers":[0,1,1,2,1,0,0,0,0]}` {
+		t.Errorf("first JSON trace entry = %s", lines[0])
+	}
+}
+
+func TestTraceErrorStopsRun(t *testing.T) {
+	sim, err := NewSimulator(DefaultConfig(), example)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := errors.New("stop")
+	err = sim.Run(10, func(s *Simulator) error {
+		if s.Day() == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || sim.Day() != 3 {
+		t.Errorf("Run() = %v at day %d, want stop at day 3", err, sim.Day())
+	}
+}
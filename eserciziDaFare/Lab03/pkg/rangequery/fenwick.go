@@ -0,0 +1,57 @@
+package rangequery
+
+import "constraints"
+
+// Fenwick is a binary indexed tree supporting point updates and range sums, both in O(log n)
+type Fenwick[T constraints.Integer] struct {
+	tree []T
+}
+
+// NewFenwick creates a Fenwick tree of n zero values
+func NewFenwick[T constraints.Integer](n int) *Fenwick[T] {
+	return &Fenwick[T]{tree: make([]T, n+1)}
+}
+
+// NewFenwickFromSlice creates a Fenwick tree holding the data in O(n)
+func NewFenwickFromSlice[T constraints.Integer](data []T) *Fenwick[T] {
+	f := NewFenwick[T](len(data))
+	for i, val := range data {
+		node := i + 1
+		f.tree[node] += val
+		if parent := node + node&-node; parent < len(f.tree) {
+			f.tree[parent] += f.tree[node]
+		}
+	}
+	return f
+}
+
+// Len returns the number of elements in the tree
+func (f *Fenwick[T]) Len() int {
+	return len(f.tree) - 1
+}
+
+// Add will add delta to the element at index i
+func (f *Fenwick[T]) Add(i int, delta T) {
+	for node := i + 1; node < len(f.tree); node += node & -node {
+		f.tree[node] += delta
+	}
+}
+
+// Set will replace the element at index i
+func (f *Fenwick[T]) Set(i int, val T) {
+	f.Add(i, val-f.Sum(i, i+1))
+}
+
+// PrefixSum returns the sum of the first n elements
+func (f *Fenwick[T]) PrefixSum(n int) T {
+	var total T
+	for node := n; node > 0; node -= node & -node {
+		total += f.tree[node]
+	}
+	return total
+}
+
+// Sum returns the sum of the elements in the half open range [lo, hi)
+func (f *Fenwick[T]) Sum(lo, hi int) T {
+	return f.PrefixSum(hi) - f.PrefixSum(lo)
+}
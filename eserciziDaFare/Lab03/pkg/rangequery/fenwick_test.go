@@ -0,0 +1,44 @@
+package rangequery
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFenwickMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 10))
+	sum := SumMonoid[int]()
+	for _, n := range []int{1, 2, 9, 64, 100} {
+		data := randomSlice(rng, n)
+		fromSlice := NewFenwickFromSlice(append([]int{}, data...))
+		incremental := NewFenwick[int](n)
+		for i, val := range data {
+			incremental.Add(i, val)
+		}
+		for op := range 1000 {
+			switch op % 3 {
+			case 0:
+				i, delta := rng.IntN(n), rng.IntN(20)-10
+				data[i] += delta
+				fromSlice.Add(i, delta)
+				incremental.Add(i, delta)
+			case 1:
+				i, val := rng.IntN(n), rng.IntN(200)-100
+				data[i] = val
+				fromSlice.Set(i, val)
+				incremental.Set(i, val)
+			}
+			lo, hi := randomRange(rng, n)
+			want := bruteCombine(data, lo, hi, sum)
+			if got := fromSlice.Sum(lo, hi); got != want {
+				t.Fatalf("n=%d: Sum(%d, %d) = %d, want %d", n, lo, hi, got, want)
+			}
+			if got := incremental.Sum(lo, hi); got != want {
+				t.Fatalf("n=%d: Sum(%d, %d) built by Add = %d, want %d", n, lo, hi, got, want)
+			}
+			if got, want := fromSlice.PrefixSum(hi), bruteCombine(data, 0, hi, sum); got != want {
+				t.Fatalf("n=%d: PrefixSum(%d) = %d, want %d", n, hi, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package rangequery
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// LCA answers lowest common ancestor queries on a rooted tree. Only nodes reachable from the
+// root can be queried, the implementations panic for any other node
+type LCA interface {
+	// LCA returns the deepest node that is an ancestor of both u and v
+	LCA(u, v int) int
+	// Depth returns the number of edges between the root and u
+	Depth(u int) int
+	// Distance returns the number of edges on the path between u and v
+	Distance(u, v int) int
+}
+
+// treeWalk records a depth first traversal of the tree reachable from root. The adjacency lists
+// may be undirected, the parent of each node is skipped when visiting its neighbours
+type treeWalk struct {
+	parent []int
+	depth  []int
+	euler  []int // nodes in the order they were entered or returned to
+	first  []int // first index of each node in euler
+	// reached marks the nodes visited from root, the others keep a parent of -1 and a depth of 0
+	reached []bool
+}
+
+// requireReached panics if u was not visited from the root, as its depth and ancestors are meaningless
+func requireReached(reached []bool, u int) {
+	if !reached[u] {
+		panic(fmt.Sprintf("node %d is not reachable from the root", u))
+	}
+}
+
+func walkTree(adjacency [][]int, root int) treeWalk {
+	n := len(adjacency)
+	w := treeWalk{
+		parent:  make([]int, n),
+		depth:   make([]int, n),
+		first:   make([]int, n),
+		euler:   make([]int, 0, 2*n),
+		reached: make([]bool, n),
+	}
+	for i := range w.parent {
+		w.parent[i] = -1
+	}
+
+	// Iterative so deep trees cannot overflow the stack, next holds the neighbour to visit next
+	type frame struct{ node, next int }
+	stack := []frame{{node: root}}
+	w.first[root] = 0
+	w.reached[root] = true
+	w.euler = append(w.euler, root)
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(adjacency[top.node]) {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				w.euler = append(w.euler, stack[len(stack)-1].node)
+			}
+			continue
+		}
+		child := adjacency[top.node][top.next]
+		top.next++
+		if child == w.parent[top.node] || child == root {
+			continue
+		}
+		w.parent[child] = top.node
+		w.depth[child] = w.depth[top.node] + 1
+		w.first[child] = len(w.euler)
+		w.reached[child] = true
+		w.euler = append(w.euler, child)
+		stack = append(stack, frame{node: child})
+	}
+	return w
+}
+
+// EulerLCA answers queries in O(1) after O(n log n) preparation, using a sparse table over the
+// depths of an Euler tour of the tree
+type EulerLCA struct {
+	walk   treeWalk
+	depths *SparseTable[int]
+}
+
+// NewEulerLCA prepares lowest common ancestor queries for the tree reachable from root
+func NewEulerLCA(adjacency [][]int, root int) *EulerLCA {
+	w := walkTree(adjacency, root)
+	tourDepths := make([]int, len(w.euler))
+	for i, node := range w.euler {
+		tourDepths[i] = w.depth[node]
+	}
+	return &EulerLCA{walk: w, depths: NewMinSparseTable(tourDepths)}
+}
+
+// LCA returns the deepest node that is an ancestor of both u and v
+func (e *EulerLCA) LCA(u, v int) int {
+	requireReached(e.walk.reached, u)
+	requireReached(e.walk.reached, v)
+	lo, hi := e.walk.first[u], e.walk.first[v]
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	// The shallowest node visited between u and v on the tour is their common ancestor
+	return e.walk.euler[e.depths.QueryIndex(lo, hi+1)]
+}
+
+// Depth returns the number of edges between the root and u
+func (e *EulerLCA) Depth(u int) int {
+	requireReached(e.walk.reached, u)
+	return e.walk.depth[u]
+}
+
+// Distance returns the number of edges on the path between u and v
+func (e *EulerLCA) Distance(u, v int) int {
+	return e.walk.depth[u] + e.walk.depth[v] - 2*e.walk.depth[e.LCA(u, v)]
+}
+
+// BinaryLiftingLCA answers queries in O(log n) after O(n log n) preparation, storing the 2^k-th
+// ancestor of every node. It also supports finding the k-th ancestor of a node
+type BinaryLiftingLCA struct {
+	depth   []int
+	reached []bool
+	up      [][]int // up[k][u] is the 2^k-th ancestor of u, or the root if there is none
+}
+
+// NewBinaryLiftingLCA prepares lowest common ancestor queries for the tree reachable from root
+func NewBinaryLiftingLCA(adjacency [][]int, root int) *BinaryLiftingLCA {
+	w := walkTree(adjacency, root)
+	levels := max(1, bits.Len(uint(len(adjacency))))
+	up := make([][]int, levels)
+	up[0] = make([]int, len(adjacency))
+	for u, p := range w.parent {
+		if p == -1 {
+			p = root
+		}
+		up[0][u] = p
+	}
+	for k := 1; k < levels; k++ {
+		up[k] = make([]int, len(adjacency))
+		for u := range up[k] {
+			up[k][u] = up[k-1][up[k-1][u]]
+		}
+	}
+	return &BinaryLiftingLCA{depth: w.depth, reached: w.reached, up: up}
+}
+
+// KthAncestor returns the ancestor k edges above u, or -1 if u is not that deep
+func (b *BinaryLiftingLCA) KthAncestor(u, k int) int {
+	requireReached(b.reached, u)
+	if k > b.depth[u] {
+		return -1
+	}
+	for level := 0; k > 0; level, k = level+1, k>>1 {
+		if k&1 == 1 {
+			u = b.up[level][u]
+		}
+	}
+	return u
+}
+
+// LCA returns the deepest node that is an ancestor of both u and v
+func (b *BinaryLiftingLCA) LCA(u, v int) int {
+	requireReached(b.reached, u)
+	requireReached(b.reached, v)
+	if b.depth[u] < b.depth[v] {
+		u, v = v, u
+	}
+	u = b.KthAncestor(u, b.depth[u]-b.depth[v])
+	if u == v {
+		return u
+	}
+	// Lift both as far as possible while they remain below the common ancestor
+	for k := len(b.up) - 1; k >= 0; k-- {
+		if b.up[k][u] != b.up[k][v] {
+			u, v = b.up[k][u], b.up[k][v]
+		}
+	}
+	return b.up[0][u]
+}
+
+// Depth returns the number of edges between the root and u
+func (b *BinaryLiftingLCA) Depth(u int) int {
+	requireReached(b.reached, u)
+	return b.depth[u]
+}
+
+// Distance returns the number of edges on the path between u and v
+func (b *BinaryLiftingLCA) Distance(u, v int) int {
+	return b.depth[u] + b.depth[v] - 2*b.depth[b.LCA(u, v)]
+}
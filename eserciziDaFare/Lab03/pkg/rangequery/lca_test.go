@@ -0,0 +1,113 @@
+package rangequery
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// randomTree returns the undirected adjacency lists of a random tree on n nodes and the parent of
+// each node when rooted at root
+func randomTree(rng *rand.Rand, n int) (adjacency [][]int, parent []int, root int) {
+	order := rng.Perm(n)
+	adjacency = make([][]int, n)
+	parent = make([]int, n)
+	root = order[0]
+	parent[root] = -1
+	for i := 1; i < n; i++ {
+		child, p := order[i], order[rng.IntN(i)]
+		parent[child] = p
+		adjacency[p] = append(adjacency[p], child)
+		adjacency[child] = append(adjacency[child], p)
+	}
+	return adjacency, parent, root
+}
+
+func naiveDepth(parent []int, u int) int {
+	depth := 0
+	for ; parent[u] != -1; u = parent[u] {
+		depth++
+	}
+	return depth
+}
+
+func naiveLCA(parent []int, u, v int) int {
+	ancestors := make(map[int]bool)
+	for ; u != -1; u = parent[u] {
+		ancestors[u] = true
+	}
+	for !ancestors[v] {
+		v = parent[v]
+	}
+	return v
+}
+
+func TestLCAMatchesParentWalk(t *testing.T) {
+	rng := rand.New(rand.NewPCG(15, 16))
+	for _, n := range []int{1, 2, 3, 10, 200} {
+		adjacency, parent, root := randomTree(rng, n)
+		implementations := map[string]LCA{
+			"euler":          NewEulerLCA(adjacency, root),
+			"binary lifting": NewBinaryLiftingLCA(adjacency, root),
+		}
+		for name, lca := range implementations {
+			for range 500 {
+				u, v := rng.IntN(n), rng.IntN(n)
+				want := naiveLCA(parent, u, v)
+				if got := lca.LCA(u, v); got != want {
+					t.Fatalf("%s n=%d: LCA(%d, %d) = %d, want %d", name, n, u, v, got, want)
+				}
+				if got := lca.Depth(u); got != naiveDepth(parent, u) {
+					t.Fatalf("%s n=%d: Depth(%d) = %d, want %d", name, n, u, got, naiveDepth(parent, u))
+				}
+				wantDistance := naiveDepth(parent, u) + naiveDepth(parent, v) - 2*naiveDepth(parent, want)
+				if got := lca.Distance(u, v); got != wantDistance {
+					t.Fatalf("%s n=%d: Distance(%d, %d) = %d, want %d", name, n, u, v, got, wantDistance)
+				}
+			}
+		}
+	}
+}
+
+func TestKthAncestor(t *testing.T) {
+	rng := rand.New(rand.NewPCG(17, 18))
+	adjacency, parent, root := randomTree(rng, 300)
+	lca := NewBinaryLiftingLCA(adjacency, root)
+	for u := range adjacency {
+		ancestor := u
+		for k := 0; k <= naiveDepth(parent, u)+1; k++ {
+			if got := lca.KthAncestor(u, k); got != ancestor {
+				t.Fatalf("KthAncestor(%d, %d) = %d, want %d", u, k, got, ancestor)
+			}
+			if ancestor != -1 {
+				ancestor = parent[ancestor]
+			}
+		}
+	}
+}
+
+func TestLCAUnreachablePanics(t *testing.T) {
+	// Node 3 is not connected to the tree rooted at 0
+	adjacency := [][]int{{1, 2}, {0}, {0}, {}}
+	implementations := map[string]LCA{
+		"euler":          NewEulerLCA(adjacency, 0),
+		"binary lifting": NewBinaryLiftingLCA(adjacency, 0),
+	}
+	for name, lca := range implementations {
+		for query, call := range map[string]func(){
+			"LCA":      func() { lca.LCA(1, 3) },
+			"Depth":    func() { lca.Depth(3) },
+			"Distance": func() { lca.Distance(3, 2) },
+		} {
+			func() {
+				defer func() {
+					r := recover()
+					if msg, ok := r.(string); !ok || !strings.Contains(msg, "not reachable") {
+						t.Errorf("%s %s: unexpected panic %v", name, query, r)
+					}
+				}()
+				call()
+			}()
+		}
+	}
+}
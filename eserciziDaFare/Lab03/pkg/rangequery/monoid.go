@@ -0,0 +1,102 @@
+package rangequery
+
+import (
+	"constraints"
+	"unsafe"
+)
+
+// Monoid describes how segment tree values combine. Combine must be associative and
+// Identity must leave any value unchanged when combined with it
+type Monoid[T any] struct {
+	Identity T
+	Combine  func(a, b T) T
+}
+
+// SumMonoid combines values by addition
+func SumMonoid[T constraints.Integer]() Monoid[T] {
+	return Monoid[T]{Identity: 0, Combine: func(a, b T) T { return a + b }}
+}
+
+// MinMonoid combines values by taking the smaller
+func MinMonoid[T constraints.Integer]() Monoid[T] {
+	return Monoid[T]{Identity: maxValue[T](), Combine: func(a, b T) T { return min(a, b) }}
+}
+
+// MaxMonoid combines values by taking the larger
+func MaxMonoid[T constraints.Integer]() Monoid[T] {
+	return Monoid[T]{Identity: minValue[T](), Combine: func(a, b T) T { return max(a, b) }}
+}
+
+// GCDMonoid combines values by taking their greatest common divisor
+func GCDMonoid[T constraints.Integer]() Monoid[T] {
+	return Monoid[T]{Identity: 0, Combine: gcd[T]}
+}
+
+func gcd[T constraints.Integer](a, b T) T {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func maxValue[T constraints.Integer]() T {
+	var zero T
+	if ^zero < 0 {
+		bits := unsafe.Sizeof(zero) * 8
+		return T(uint64(1)<<(bits-1) - 1)
+	}
+	return ^zero
+}
+
+func minValue[T constraints.Integer]() T {
+	var zero T
+	if ^zero < 0 {
+		return -maxValue[T]() - 1
+	}
+	return zero
+}
+
+// Action describes a range update applied lazily to a segment tree. Apply updates the combined value
+// of a segment of the given length, and Compose merges a newer update with an older pending one
+type Action[T, F any] struct {
+	Apply   func(f F, val T, length int) T
+	Compose func(newer, older F) F
+}
+
+// AddToSum adds a value to every element of a range, for use with SumMonoid
+func AddToSum[T constraints.Integer]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(f, val T, length int) T { return val + f*T(length) },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// AddToExtreme adds a value to every element of a range, for use with MinMonoid or MaxMonoid
+func AddToExtreme[T constraints.Integer]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(f, val T, length int) T { return val + f },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// AssignToSum replaces every element of a range with a value, for use with SumMonoid
+func AssignToSum[T constraints.Integer]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(f, val T, length int) T { return f * T(length) },
+		Compose: func(newer, older T) T { return newer },
+	}
+}
+
+// AssignToExtreme replaces every element of a range with a value, for use with MinMonoid or MaxMonoid
+func AssignToExtreme[T constraints.Integer]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(f, val T, length int) T { return f },
+		Compose: func(newer, older T) T { return newer },
+	}
+}
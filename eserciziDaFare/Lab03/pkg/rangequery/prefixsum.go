@@ -0,0 +1,48 @@
+package rangequery
+
+import (
+	"adventofcode2021/pkg/matrices"
+	"constraints"
+)
+
+// PrefixSum answers sums over any range of a fixed slice in O(1) after O(n) preparation
+type PrefixSum[T constraints.Integer] struct {
+	sums []T
+}
+
+// NewPrefixSum prepares the prefix sums of the data
+func NewPrefixSum[T constraints.Integer](data []T) PrefixSum[T] {
+	sums := make([]T, len(data)+1)
+	for i, val := range data {
+		sums[i+1] = sums[i] + val
+	}
+	return PrefixSum[T]{sums: sums}
+}
+
+// Sum returns the sum of the elements in the half open range [lo, hi)
+func (p PrefixSum[T]) Sum(lo, hi int) T {
+	return p.sums[hi] - p.sums[lo]
+}
+
+// PrefixSum2D answers sums over any rectangle of a fixed matrix in O(1) after O(rows*columns) preparation
+type PrefixSum2D[T constraints.Integer] struct {
+	sums [][]T
+}
+
+// NewPrefixSum2D prepares the prefix sums of the matrix, where sums[y][x] totals every
+// element above and to the left of (x, y)
+func NewPrefixSum2D[T constraints.Integer](m matrices.IntMatrix[T]) PrefixSum2D[T] {
+	sums := make([][]T, m.Rows+1)
+	for y := range sums {
+		sums[y] = make([]T, m.Columns+1)
+	}
+	m.ForEach(func(x, y int, value T) {
+		sums[y+1][x+1] = value + sums[y][x+1] + sums[y+1][x] - sums[y][x]
+	})
+	return PrefixSum2D[T]{sums: sums}
+}
+
+// Sum returns the sum of the elements in the half open rectangle [x0, x1) by [y0, y1)
+func (p PrefixSum2D[T]) Sum(x0, y0, x1, y1 int) T {
+	return p.sums[y1][x1] - p.sums[y0][x1] - p.sums[y1][x0] + p.sums[y0][x0]
+}
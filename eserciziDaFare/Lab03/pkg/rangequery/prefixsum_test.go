@@ -0,0 +1,44 @@
+package rangequery
+
+import (
+	"adventofcode2021/pkg/matrices"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPrefixSumMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(11, 12))
+	data := randomSlice(rng, 50)
+	p := NewPrefixSum(data)
+	for range 500 {
+		lo, hi := randomRange(rng, len(data))
+		if got, want := p.Sum(lo, hi), bruteCombine(data, lo, hi, SumMonoid[int]()); got != want {
+			t.Fatalf("Sum(%d, %d) = %d, want %d", lo, hi, got, want)
+		}
+	}
+}
+
+func TestPrefixSum2DMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(13, 14))
+	for _, size := range [][2]int{{1, 1}, {1, 9}, {7, 1}, {12, 17}} {
+		rows, columns := size[0], size[1]
+		data := make([][]int, rows)
+		for y := range data {
+			data[y] = randomSlice(rng, columns)
+		}
+		p := NewPrefixSum2D(matrices.NewIntMatrixFromData(data))
+		for range 500 {
+			x0, x1 := randomRange(rng, columns)
+			y0, y1 := randomRange(rng, rows)
+			want := 0
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					want += data[y][x]
+				}
+			}
+			if got := p.Sum(x0, y0, x1, y1); got != want {
+				t.Fatalf("%dx%d: Sum(%d, %d, %d, %d) = %d, want %d", columns, rows, x0, y0, x1, y1, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package rangequery
+
+// SegmentTree combines any range of values with a monoid in O(log n) and supports point updates
+type SegmentTree[T any] struct {
+	n      int
+	tree   []T
+	monoid Monoid[T]
+}
+
+// NewSegmentTree builds a segment tree over the data in O(n)
+func NewSegmentTree[T any](data []T, monoid Monoid[T]) *SegmentTree[T] {
+	n := len(data)
+	s := &SegmentTree[T]{n: n, tree: make([]T, 2*n), monoid: monoid}
+	copy(s.tree[n:], data)
+	for i := n - 1; i > 0; i-- {
+		s.tree[i] = monoid.Combine(s.tree[2*i], s.tree[2*i+1])
+	}
+	return s
+}
+
+// Len returns the number of elements in the tree
+func (s *SegmentTree[T]) Len() int {
+	return s.n
+}
+
+// Set will replace the element at index i
+func (s *SegmentTree[T]) Set(i int, val T) {
+	i += s.n
+	s.tree[i] = val
+	for i /= 2; i > 0; i /= 2 {
+		s.tree[i] = s.monoid.Combine(s.tree[2*i], s.tree[2*i+1])
+	}
+}
+
+// Get returns the element at index i
+func (s *SegmentTree[T]) Get(i int) T {
+	return s.tree[i+s.n]
+}
+
+// Query combines the elements in the half open range [lo, hi), the identity is returned for an empty range
+func (s *SegmentTree[T]) Query(lo, hi int) T {
+	// Combine from both ends separately so non commutative monoids keep their order
+	left, right := s.monoid.Identity, s.monoid.Identity
+	for lo, hi = lo+s.n, hi+s.n; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo%2 == 1 {
+			left = s.monoid.Combine(left, s.tree[lo])
+			lo++
+		}
+		if hi%2 == 1 {
+			hi--
+			right = s.monoid.Combine(s.tree[hi], right)
+		}
+	}
+	return s.monoid.Combine(left, right)
+}
+
+// LazySegmentTree combines any range of values with a monoid and applies an update to any range,
+// both in O(log n). Updates are stored at the highest covering nodes and pushed down only when needed
+type LazySegmentTree[T, F any] struct {
+	n       int
+	tree    []T
+	lazy    []F
+	pending []bool
+	monoid  Monoid[T]
+	action  Action[T, F]
+}
+
+// NewLazySegmentTree builds a lazy segment tree over the data in O(n)
+func NewLazySegmentTree[T, F any](data []T, monoid Monoid[T], action Action[T, F]) *LazySegmentTree[T, F] {
+	n := len(data)
+	s := &LazySegmentTree[T, F]{
+		n:       n,
+		tree:    make([]T, 4*max(n, 1)),
+		lazy:    make([]F, 4*max(n, 1)),
+		pending: make([]bool, 4*max(n, 1)),
+		monoid:  monoid,
+		action:  action,
+	}
+	if n > 0 {
+		s.build(1, 0, n, data)
+	}
+	return s
+}
+
+// Len returns the number of elements in the tree
+func (s *LazySegmentTree[T, F]) Len() int {
+	return s.n
+}
+
+func (s *LazySegmentTree[T, F]) build(node, lo, hi int, data []T) {
+	if hi-lo == 1 {
+		s.tree[node] = data[lo]
+		return
+	}
+	mid := lo + (hi-lo)/2
+	s.build(2*node, lo, mid, data)
+	s.build(2*node+1, mid, hi, data)
+	s.tree[node] = s.monoid.Combine(s.tree[2*node], s.tree[2*node+1])
+}
+
+func (s *LazySegmentTree[T, F]) applyNode(node, lo, hi int, f F) {
+	s.tree[node] = s.action.Apply(f, s.tree[node], hi-lo)
+	if s.pending[node] {
+		s.lazy[node] = s.action.Compose(f, s.lazy[node])
+	} else {
+		s.lazy[node] = f
+		s.pending[node] = true
+	}
+}
+
+func (s *LazySegmentTree[T, F]) push(node, lo, hi int) {
+	if !s.pending[node] {
+		return
+	}
+	mid := lo + (hi-lo)/2
+	s.applyNode(2*node, lo, mid, s.lazy[node])
+	s.applyNode(2*node+1, mid, hi, s.lazy[node])
+	s.pending[node] = false
+}
+
+// Update applies f to every element in the half open range [lo, hi)
+func (s *LazySegmentTree[T, F]) Update(lo, hi int, f F) {
+	if lo < hi {
+		s.update(1, 0, s.n, lo, hi, f)
+	}
+}
+
+func (s *LazySegmentTree[T, F]) update(node, lo, hi, qlo, qhi int, f F) {
+	if qhi <= lo || hi <= qlo {
+		return
+	}
+	if qlo <= lo && hi <= qhi {
+		s.applyNode(node, lo, hi, f)
+		return
+	}
+	s.push(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	s.update(2*node, lo, mid, qlo, qhi, f)
+	s.update(2*node+1, mid, hi, qlo, qhi, f)
+	s.tree[node] = s.monoid.Combine(s.tree[2*node], s.tree[2*node+1])
+}
+
+// Set will replace the element at index i
+func (s *LazySegmentTree[T, F]) Set(i int, val T) {
+	s.set(1, 0, s.n, i, val)
+}
+
+func (s *LazySegmentTree[T, F]) set(node, lo, hi, i int, val T) {
+	if hi-lo == 1 {
+		s.tree[node] = val
+		return
+	}
+	s.push(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	if i < mid {
+		s.set(2*node, lo, mid, i, val)
+	} else {
+		s.set(2*node+1, mid, hi, i, val)
+	}
+	s.tree[node] = s.monoid.Combine(s.tree[2*node], s.tree[2*node+1])
+}
+
+// Query combines the elements in the half open range [lo, hi), the identity is returned for an empty range
+func (s *LazySegmentTree[T, F]) Query(lo, hi int) T {
+	if lo >= hi {
+		return s.monoid.Identity
+	}
+	return s.query(1, 0, s.n, lo, hi)
+}
+
+func (s *LazySegmentTree[T, F]) query(node, lo, hi, qlo, qhi int) T {
+	if qhi <= lo || hi <= qlo {
+		return s.monoid.Identity
+	}
+	if qlo <= lo && hi <= qhi {
+		return s.tree[node]
+	}
+	s.push(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	return s.monoid.Combine(s.query(2*node, lo, mid, qlo, qhi), s.query(2*node+1, mid, hi, qlo, qhi))
+}
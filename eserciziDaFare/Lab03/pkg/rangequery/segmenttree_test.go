@@ -0,0 +1,101 @@
+package rangequery
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func randomSlice(rng *rand.Rand, n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = rng.IntN(200) - 100
+	}
+	return data
+}
+
+func randomRange(rng *rand.Rand, n int) (int, int) {
+	lo := rng.IntN(n + 1)
+	hi := lo + rng.IntN(n-lo+1)
+	return lo, hi
+}
+
+func bruteCombine(data []int, lo, hi int, monoid Monoid[int]) int {
+	result := monoid.Identity
+	for _, val := range data[lo:hi] {
+		result = monoid.Combine(result, val)
+	}
+	return result
+}
+
+func TestSegmentTreeMatchesBruteForce(t *testing.T) {
+	monoids := map[string]Monoid[int]{
+		"sum": SumMonoid[int](),
+		"min": MinMonoid[int](),
+		"max": MaxMonoid[int](),
+		"gcd": GCDMonoid[int](),
+	}
+	for name, monoid := range monoids {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(5, 6))
+			for _, n := range []int{0, 1, 2, 7, 64, 100} {
+				data := randomSlice(rng, n)
+				tree := NewSegmentTree(append([]int{}, data...), monoid)
+				for op := range 500 {
+					if n > 0 && op%3 == 0 {
+						i, val := rng.IntN(n), rng.IntN(200)-100
+						data[i] = val
+						tree.Set(i, val)
+						if tree.Get(i) != val {
+							t.Fatalf("Get(%d) = %d after Set(%d)", i, tree.Get(i), val)
+						}
+						continue
+					}
+					lo, hi := randomRange(rng, n)
+					if got, want := tree.Query(lo, hi), bruteCombine(data, lo, hi, monoid); got != want {
+						t.Fatalf("n=%d: Query(%d, %d) = %d, want %d", n, lo, hi, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLazySegmentTreeMatchesBruteForce(t *testing.T) {
+	cases := map[string]struct {
+		monoid Monoid[int]
+		action Action[int, int]
+		update func(old, f int) int
+	}{
+		"add to sum":        {SumMonoid[int](), AddToSum[int](), func(old, f int) int { return old + f }},
+		"add to min":        {MinMonoid[int](), AddToExtreme[int](), func(old, f int) int { return old + f }},
+		"assign to sum":     {SumMonoid[int](), AssignToSum[int](), func(old, f int) int { return f }},
+		"assign to extreme": {MaxMonoid[int](), AssignToExtreme[int](), func(old, f int) int { return f }},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(7, 8))
+			for _, n := range []int{1, 2, 13, 64, 100} {
+				data := randomSlice(rng, n)
+				tree := NewLazySegmentTree(append([]int{}, data...), tc.monoid, tc.action)
+				for op := range 1000 {
+					lo, hi := randomRange(rng, n)
+					switch op % 4 {
+					case 0, 1:
+						f := rng.IntN(20) - 10
+						tree.Update(lo, hi, f)
+						for i := lo; i < hi; i++ {
+							data[i] = tc.update(data[i], f)
+						}
+					case 2:
+						i, val := rng.IntN(n), rng.IntN(200)-100
+						data[i] = val
+						tree.Set(i, val)
+					}
+					if got, want := tree.Query(lo, hi), bruteCombine(data, lo, hi, tc.monoid); got != want {
+						t.Fatalf("n=%d op=%d: Query(%d, %d) = %d, want %d", n, op, lo, hi, got, want)
+					}
+				}
+			}
+		})
+	}
+}
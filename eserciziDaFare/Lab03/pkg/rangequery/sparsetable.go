@@ -0,0 +1,66 @@
+package rangequery
+
+import (
+	"constraints"
+	"math/bits"
+)
+
+// SparseTable answers minimum or maximum queries over any range of a fixed slice in O(1)
+// after O(n log n) preparation
+type SparseTable[T constraints.Ordered] struct {
+	data   []T
+	table  [][]int // table[k][i] is the index of the best element in [i, i+2^k)
+	better func(a, b T) bool
+}
+
+// NewMinSparseTable prepares range minimum queries over the data
+func NewMinSparseTable[T constraints.Ordered](data []T) *SparseTable[T] {
+	return newSparseTable(data, func(a, b T) bool { return a < b })
+}
+
+// NewMaxSparseTable prepares range maximum queries over the data
+func NewMaxSparseTable[T constraints.Ordered](data []T) *SparseTable[T] {
+	return newSparseTable(data, func(a, b T) bool { return a > b })
+}
+
+func newSparseTable[T constraints.Ordered](data []T, better func(a, b T) bool) *SparseTable[T] {
+	s := &SparseTable[T]{data: data, better: better}
+	level := make([]int, len(data))
+	for i := range level {
+		level[i] = i
+	}
+	s.table = append(s.table, level)
+	for width := 1; 2*width <= len(data); width *= 2 {
+		prev := s.table[len(s.table)-1]
+		level := make([]int, len(data)-2*width+1)
+		for i := range level {
+			level[i] = s.pick(prev[i], prev[i+width])
+		}
+		s.table = append(s.table, level)
+	}
+	return s
+}
+
+// pick returns the index of the better element, preferring the first on ties
+func (s *SparseTable[T]) pick(i, j int) int {
+	if s.better(s.data[j], s.data[i]) {
+		return j
+	}
+	return i
+}
+
+// QueryIndex returns the index of the minimum (or maximum) element in the half open range [lo, hi),
+// the leftmost on ties. The range must not be empty
+func (s *SparseTable[T]) QueryIndex(lo, hi int) int {
+	if lo >= hi {
+		panic("query of an empty range is not supported")
+	}
+	// Two overlapping power of two ranges cover [lo, hi) exactly
+	k := bits.Len(uint(hi-lo)) - 1
+	return s.pick(s.table[k][lo], s.table[k][hi-(1<<k)])
+}
+
+// Query returns the minimum (or maximum) element in the half open range [lo, hi). The range must not be empty
+func (s *SparseTable[T]) Query(lo, hi int) T {
+	return s.data[s.QueryIndex(lo, hi)]
+}
@@ -0,0 +1,120 @@
+package seqs
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"iter"
+)
+
+// Values returns an iterator over the elements of a slice, the start of a lazy pipeline.
+// Iterators from sets.Set.All and the slices generators can be used the same way
+func Values[T any](source []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range source {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// Filter lazily yields the elements that match the provided predicate
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for entry := range seq {
+			if predicate(entry) && !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// Map lazily converts each element using the selector
+func Map[T, U any](seq iter.Seq[U], selector func(U) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for entry := range seq {
+			if !yield(selector(entry)) {
+				return
+			}
+		}
+	}
+}
+
+// Take lazily yields at most the first n elements
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for entry := range seq {
+			if !yield(entry) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip lazily yields all but the first n elements
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for entry := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// Zip lazily pairs up the elements of two iterators by position, stopping when either runs out
+func Zip[T, U any](keys iter.Seq[T], values iter.Seq[U]) iter.Seq[tuples.Pair[T, U]] {
+	return func(yield func(tuples.Pair[T, U]) bool) {
+		nextValue, stop := iter.Pull(values)
+		defer stop()
+		for key := range keys {
+			value, ok := nextValue()
+			if !ok || !yield(tuples.Pair[T, U]{Key: key, Value: value}) {
+				return
+			}
+		}
+	}
+}
+
+// Chain lazily yields the elements of each iterator in turn
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for entry := range seq {
+				if !yield(entry) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect will run the iterator and gather its elements into a slice
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := []T{}
+	for entry := range seq {
+		result = append(result, entry)
+	}
+	return result
+}
+
+// Reduce will run the iterator and combine its elements into a single value starting from initial
+func Reduce[T, U any](seq iter.Seq[T], initial U, accumulator func(acc U, val T) U) U {
+	result := initial
+	for entry := range seq {
+		result = accumulator(result, entry)
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+package seqs
+
+import (
+	"adventofcode2021/pkg/slices"
+	"adventofcode2021/pkg/tuples"
+	"iter"
+	"reflect"
+	"testing"
+)
+
+// naturals yields 0, 1, 2, ... forever, recording how many values were produced
+// and whether the consumer stopped it
+func naturals(produced *int, stopped *bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*produced++
+			if !yield(i) {
+				*stopped = true
+				return
+			}
+		}
+	}
+}
+
+func TestTakeStopsEarly(t *testing.T) {
+	produced, stopped := 0, false
+	got := Collect(Take(naturals(&produced, &stopped), 5))
+	if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Take() = %v", got)
+	}
+	if produced != 5 {
+		t.Errorf("Take(5) pulled %d values from the source", produced)
+	}
+	if got := Collect(Take(naturals(&produced, &stopped), 0)); len(got) != 0 {
+		t.Errorf("Take(0) = %v", got)
+	}
+}
+
+func TestZipStopsEarly(t *testing.T) {
+	produced, stopped := 0, false
+	got := Collect(Zip(Values([]string{"a", "b", "c"}), naturals(&produced, &stopped)))
+	want := []tuples.Pair[string, int]{tuples.NewPair("a", 0), tuples.NewPair("b", 1), tuples.NewPair("c", 2)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+	if !stopped {
+		t.Error("Zip() did not stop the pulled iterator")
+	}
+
+	keysProduced, keysStopped := 0, false
+	produced, stopped = 0, false
+	for pair := range Zip(naturals(&keysProduced, &keysStopped), naturals(&produced, &stopped)) {
+		if pair.Key == 3 {
+			break
+		}
+	}
+	if !keysStopped || !stopped || keysProduced != 4 || produced != 4 {
+		t.Errorf("breaking out of Zip() produced %d keys and %d values", keysProduced, produced)
+	}
+}
+
+func TestChainStopsEarly(t *testing.T) {
+	firstProduced, firstStopped := 0, false
+	secondProduced, secondStopped := 0, false
+	got := Collect(Take(Chain(Values([]int{-2, -1}), naturals(&firstProduced, &firstStopped), naturals(&secondProduced, &secondStopped)), 4))
+	if !reflect.DeepEqual(got, []int{-2, -1, 0, 1}) {
+		t.Errorf("Chain() = %v", got)
+	}
+	if !firstStopped || firstProduced != 2 {
+		t.Errorf("Chain() pulled %d values from the infinite iterator", firstProduced)
+	}
+	if secondProduced != 0 {
+		t.Error("Chain() started an iterator after the consumer stopped")
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	even := func(val int) bool { return val%2 == 0 }
+	square := func(val int) int { return val * val }
+	got := Collect(Map(Filter(Values(source), even), square))
+	want := slices.Map(slices.Filter(source, even), square)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lazy pipeline = %v, eager = %v", got, want)
+	}
+	if sum := Reduce(Skip(Values(source), 7), 0, func(acc, val int) int { return acc + val }); sum != 27 {
+		t.Errorf("Reduce(Skip()) = %d, want 27", sum)
+	}
+}
+
+var benchmarkSource = func() []int {
+	source := make([]int, 10000)
+	for i := range source {
+		source[i] = i
+	}
+	return source
+}()
+
+func isEven(val int) bool { return val%2 == 0 }
+
+func double(val int) int { return val * 2 }
+
+func BenchmarkEagerFilterMap(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		slices.Map(slices.Filter(benchmarkSource, isEven), double)
+	}
+}
+
+func BenchmarkLazyFilterMap(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		Collect(Map(Filter(Values(benchmarkSource), isEven), double))
+	}
+}
+
+func BenchmarkEagerFilterMapFirst10(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		_ = slices.Map(slices.Filter(benchmarkSource, isEven), double)[:10]
+	}
+}
+
+func BenchmarkLazyFilterMapFirst10(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		Collect(Take(Map(Filter(Values(benchmarkSource), isEven), double), 10))
+	}
+}
@@ -0,0 +1,41 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the set as a JSON array of its elements (undefined order)
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing any existing elements
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var entries []T
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	*s = NewSetFromSlice(entries)
+	return nil
+}
+
+// MarshalBinary encodes the set elements using gob, this is also used when the set is gob encoded
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into the set, replacing any existing elements
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	var entries []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return err
+	}
+	*s = NewSetFromSlice(entries)
+	return nil
+}
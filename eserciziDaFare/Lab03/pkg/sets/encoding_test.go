@@ -0,0 +1,45 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSetJSONRoundTrip(t *testing.T) {
+	s := NewSetFromSlice([]string{"a", "b", "c"})
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Set[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, s) {
+		t.Errorf("JSON round trip = %v, want %v", decoded, s)
+	}
+	if err := json.Unmarshal([]byte(`[1, 2]`), &decoded); err == nil {
+		t.Error("json.Unmarshal() accepted numbers into a set of strings")
+	}
+}
+
+func TestSetGobRoundTrip(t *testing.T) {
+	s := NewSetFromSlice([]int{4, 8, 15, 16, 23, 42})
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Set[int]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, s) {
+		t.Errorf("gob round trip = %v, want %v", decoded, s)
+	}
+	if err := decoded.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("UnmarshalBinary() accepted invalid data")
+	}
+}
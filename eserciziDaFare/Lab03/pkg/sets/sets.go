@@ -1,5 +1,10 @@
 package sets
 
+import (
+	"constraints"
+	"iter"
+)
+
 type Set[T comparable] map[T]struct{}
 
 // NewEmptySet generates an empty set
@@ -16,6 +21,13 @@ func NewSetFromSlice[T comparable](data []T) Set[T] {
 	return result
 }
 
+// NewSetFromSeq generates a set based on the values produced by an iterator, any repeated elements will be deduped
+func NewSetFromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	result := NewEmptySet[T]()
+	result.AddSeq(seq)
+	return result
+}
+
 // Add will add an element to a set
 func (s Set[T]) Add(entry T) {
 	s[entry] = struct{}{}
@@ -28,6 +40,13 @@ func (s Set[T]) AddSlice(entries []T) {
 	}
 }
 
+// AddSeq will add all the values produced by an iterator to a set
+func (s Set[T]) AddSeq(seq iter.Seq[T]) {
+	for entry := range seq {
+		s.Add(entry)
+	}
+}
+
 // Remove will remove an element from the set
 func (s Set[T]) Remove(entry T) {
 	delete(s, entry)
@@ -68,3 +87,95 @@ func (s Set[T]) SumWeighted(weightFunc func(x T) int) int {
 	}
 	return sum
 }
+
+// All returns an iterator over the set elements (undefined order)
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Any returns true if at least one element matches the predicate
+func (s Set[T]) Any(predicate func(val T) bool) bool {
+	for k := range s {
+		if predicate(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every returns true if all elements match the predicate, an empty set always matches
+func (s Set[T]) Every(predicate func(val T) bool) bool {
+	for k := range s {
+		if !predicate(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Partition will split the set into 2 sets, with the first set elements matching
+// the predicate, the second set elements do not
+func (s Set[T]) Partition(predicate func(val T) bool) (Set[T], Set[T]) {
+	resultMatch := NewEmptySet[T]()
+	resultNotMatch := NewEmptySet[T]()
+	for k := range s {
+		if predicate(k) {
+			resultMatch.Add(k)
+		} else {
+			resultNotMatch.Add(k)
+		}
+	}
+	return resultMatch, resultNotMatch
+}
+
+// Map converts one set to another set, elements that map to the same value will be deduped
+func Map[T, U comparable](source Set[U], selector func(U) T) Set[T] {
+	result := NewEmptySet[T]()
+	for k := range source {
+		result.Add(selector(k))
+	}
+	return result
+}
+
+// Reduce will combine all elements of the set into a single value starting from initial.
+// The set order is undefined so the accumulator should not depend on it
+func Reduce[T comparable, U any](source Set[T], initial U, accumulator func(acc U, val T) U) U {
+	result := initial
+	for k := range source {
+		result = accumulator(result, k)
+	}
+	return result
+}
+
+// MinBy will return the element with the smallest key, false is returned for an empty set.
+// Ties between equal keys are resolved in an unspecified way
+func MinBy[T comparable, K constraints.Ordered](source Set[T], key func(T) K) (T, bool) {
+	return selectBy(source, key, func(a, b K) bool { return a < b })
+}
+
+// MaxBy will return the element with the largest key, false is returned for an empty set.
+// Ties between equal keys are resolved in an unspecified way
+func MaxBy[T comparable, K constraints.Ordered](source Set[T], key func(T) K) (T, bool) {
+	return selectBy(source, key, func(a, b K) bool { return a > b })
+}
+
+func selectBy[T comparable, K constraints.Ordered](source Set[T], key func(T) K, better func(a, b K) bool) (T, bool) {
+	started := false
+	var best T
+	var bestKey K
+	for k := range source {
+		testKey := key(k)
+		if !started || better(testKey, bestKey) {
+			best = k
+			bestKey = testKey
+			started = true
+		}
+	}
+	return best, started
+}
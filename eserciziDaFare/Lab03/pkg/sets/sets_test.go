@@ -0,0 +1,115 @@
+package sets
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+)
+
+func values[T any](data ...T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range data {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func TestSetFromSeq(t *testing.T) {
+	s := NewSetFromSeq(values(3, 1, 3, 2))
+	if !reflect.DeepEqual(s, NewSetFromSlice([]int{1, 2, 3})) {
+		t.Errorf("NewSetFromSeq() = %v", s)
+	}
+	s.AddSeq(values(4, 1))
+	if len(s) != 4 || !s.IsMember(4) {
+		t.Errorf("AddSeq() = %v", s)
+	}
+	if got := NewSetFromSeq(s.All()); !reflect.DeepEqual(got, s) {
+		t.Errorf("All() = %v", got)
+	}
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("All() yielded %d after break", count)
+	}
+}
+
+func TestMap(t *testing.T) {
+	s := NewSetFromSlice([]int{-2, -1, 0, 1, 2})
+	squares := Map(s, func(x int) int { return x * x })
+	if !reflect.DeepEqual(squares, NewSetFromSlice([]int{0, 1, 4})) {
+		t.Errorf("Map() = %v, want colliding values deduped", squares)
+	}
+	names := Map(s, func(x int) bool { return x > 0 })
+	if !reflect.DeepEqual(names, NewSetFromSlice([]bool{true, false})) {
+		t.Errorf("Map() to another type = %v", names)
+	}
+	if got := Map(NewEmptySet[int](), func(x int) int { return x }); len(got) != 0 {
+		t.Errorf("Map() of an empty set = %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	s := NewSetFromSlice([]int{1, 2, 3, 4, 5})
+	even, odd := s.Partition(func(x int) bool { return x%2 == 0 })
+	if !reflect.DeepEqual(even, NewSetFromSlice([]int{2, 4})) || !reflect.DeepEqual(odd, NewSetFromSlice([]int{1, 3, 5})) {
+		t.Errorf("Partition() = %v, %v", even, odd)
+	}
+	all, none := s.Partition(func(int) bool { return true })
+	if len(all) != 5 || none == nil || len(none) != 0 {
+		t.Errorf("Partition() matching everything = %v, %#v", all, none)
+	}
+	if len(s) != 5 {
+		t.Errorf("Partition() modified the source: %v", s)
+	}
+}
+
+func TestAnyEveryReduce(t *testing.T) {
+	s := NewSetFromSlice([]int{2, 4, 6})
+	even := func(x int) bool { return x%2 == 0 }
+	if !s.Every(even) || s.Any(func(x int) bool { return x > 6 }) || !s.Any(func(x int) bool { return x == 4 }) {
+		t.Error("Any()/Every() disagree with the contents")
+	}
+	empty := NewEmptySet[int]()
+	if !empty.Every(even) || empty.Any(even) {
+		t.Error("an empty set must match Every() and not Any()")
+	}
+	if got := Reduce(s, 1, func(acc, x int) int { return acc * x }); got != 48 {
+		t.Errorf("Reduce() = %d, want 48", got)
+	}
+	if got := Reduce(empty, "start", func(acc string, x int) string { return "changed" }); got != "start" {
+		t.Errorf("Reduce() of an empty set = %q", got)
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	s := NewSetFromSlice([]string{"ccc", "a", "bbbb", "dd"})
+	length := func(x string) int { return len(x) }
+	if got, ok := MinBy(s, length); !ok || got != "a" {
+		t.Errorf("MinBy() = %q, %v", got, ok)
+	}
+	if got, ok := MaxBy(s, length); !ok || got != "bbbb" {
+		t.Errorf("MaxBy() = %q, %v", got, ok)
+	}
+	// Negative keys must not be confused with the zero value of an unset best
+	if got, ok := MinBy(NewSetFromSlice([]int{3, 5}), func(x int) int { return -x }); !ok || got != 5 {
+		t.Errorf("MinBy() with negative keys = %d, %v", got, ok)
+	}
+	if got, ok := MaxBy(NewSetFromSlice([]int{3, 5}), func(x int) int { return -x }); !ok || got != 3 {
+		t.Errorf("MaxBy() with negative keys = %d, %v", got, ok)
+	}
+	if got, ok := MinBy(NewEmptySet[string](), length); ok || got != "" {
+		t.Errorf("MinBy() of an empty set = %q, %v", got, ok)
+	}
+	if _, ok := MaxBy(NewEmptySet[string](), length); ok {
+		t.Error("MaxBy() of an empty set reported a result")
+	}
+	tied, _ := MinBy(NewSetFromSlice([]string{"x", "y", "zz"}), length)
+	if tied != "x" && tied != "y" {
+		t.Errorf("MinBy() with a tie = %q", tied)
+	}
+}
@@ -0,0 +1,220 @@
+package sets
+
+import (
+	"constraints"
+	"hash/maphash"
+	"iter"
+	"sync"
+)
+
+const syncSetShards = 32
+
+// SyncSet is a set that is safe for concurrent use. Elements are spread over
+// a number of independently locked shards to reduce lock contention
+type SyncSet[T comparable] struct {
+	seed   maphash.Seed
+	shards [syncSetShards]syncSetShard[T]
+}
+
+type syncSetShard[T comparable] struct {
+	sync.RWMutex
+	data Set[T]
+}
+
+// NewSyncSet generates an empty concurrency safe set
+func NewSyncSet[T comparable]() *SyncSet[T] {
+	s := &SyncSet[T]{seed: maphash.MakeSeed()}
+	for i := range s.shards {
+		s.shards[i].data = NewEmptySet[T]()
+	}
+	return s
+}
+
+// NewSyncSetFromSlice generates a concurrency safe set based on a provided slice, any repeated elements will be deduped
+func NewSyncSetFromSlice[T comparable](data []T) *SyncSet[T] {
+	result := NewSyncSet[T]()
+	result.AddSlice(data)
+	return result
+}
+
+// NewSyncSetFromSeq generates a concurrency safe set from the values of an iterator, any repeated elements will be deduped
+func NewSyncSetFromSeq[T comparable](seq iter.Seq[T]) *SyncSet[T] {
+	result := NewSyncSet[T]()
+	result.AddSeq(seq)
+	return result
+}
+
+func (s *SyncSet[T]) shard(entry T) *syncSetShard[T] {
+	return &s.shards[maphash.Comparable(s.seed, entry)%syncSetShards]
+}
+
+// Add will add an element to a set
+func (s *SyncSet[T]) Add(entry T) {
+	sh := s.shard(entry)
+	sh.Lock()
+	sh.data.Add(entry)
+	sh.Unlock()
+}
+
+// AddIfAbsent will atomically add an element to the set, returning true if it was not already a member
+func (s *SyncSet[T]) AddIfAbsent(entry T) bool {
+	sh := s.shard(entry)
+	sh.Lock()
+	defer sh.Unlock()
+	if sh.data.IsMember(entry) {
+		return false
+	}
+	sh.data.Add(entry)
+	return true
+}
+
+// AddSlice will add multiple elements to a set
+func (s *SyncSet[T]) AddSlice(entries []T) {
+	for _, entry := range entries {
+		s.Add(entry)
+	}
+}
+
+// AddSeq will add all the values produced by an iterator to the set
+func (s *SyncSet[T]) AddSeq(seq iter.Seq[T]) {
+	for entry := range seq {
+		s.Add(entry)
+	}
+}
+
+// Remove will remove an element from the set
+func (s *SyncSet[T]) Remove(entry T) {
+	sh := s.shard(entry)
+	sh.Lock()
+	sh.data.Remove(entry)
+	sh.Unlock()
+}
+
+func (s *SyncSet[T]) IsMember(val T) bool {
+	sh := s.shard(val)
+	sh.RLock()
+	defer sh.RUnlock()
+	return sh.data.IsMember(val)
+}
+
+// Len returns the number of elements in the set
+func (s *SyncSet[T]) Len() int {
+	total := 0
+	for i := range s.shards {
+		s.shards[i].RLock()
+		total += len(s.shards[i].data)
+		s.shards[i].RUnlock()
+	}
+	return total
+}
+
+// Snapshot will copy the current elements into a plain set. Concurrent modifications
+// made while the snapshot is taken may or may not be included
+func (s *SyncSet[T]) Snapshot() Set[T] {
+	result := NewEmptySet[T]()
+	for i := range s.shards {
+		s.shards[i].RLock()
+		for k := range s.shards[i].data {
+			result.Add(k)
+		}
+		s.shards[i].RUnlock()
+	}
+	return result
+}
+
+// Filter will generate a new set containing elements that match the predicate
+func (s *SyncSet[T]) Filter(predicate func(val T) bool) *SyncSet[T] {
+	result := NewSyncSet[T]()
+	for k := range s.All() {
+		if predicate(k) {
+			result.Add(k)
+		}
+	}
+	return result
+}
+
+// ToSlice will generate a slice will all the set elements (undefined order) for iteration
+func (s *SyncSet[T]) ToSlice() []T {
+	return s.Snapshot().ToSlice()
+}
+
+// SumWeighted will sum all values in the set using the provided weighting function
+func (s *SyncSet[T]) SumWeighted(weightFunc func(x T) int) int {
+	return s.Snapshot().SumWeighted(weightFunc)
+}
+
+// Any returns true if at least one element matches the predicate
+func (s *SyncSet[T]) Any(predicate func(val T) bool) bool {
+	for k := range s.All() {
+		if predicate(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every returns true if all elements match the predicate, an empty set always matches
+func (s *SyncSet[T]) Every(predicate func(val T) bool) bool {
+	for k := range s.All() {
+		if !predicate(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns an iterator over the set elements (undefined order). Each shard is copied
+// before its elements are yielded, so the set may be modified during iteration
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range s.shards {
+			s.shards[i].RLock()
+			entries := s.shards[i].data.ToSlice()
+			s.shards[i].RUnlock()
+			for _, k := range entries {
+				if !yield(k) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Partition will split the set into 2 new sets, with the first set elements matching
+// the predicate, the second set elements do not
+func (s *SyncSet[T]) Partition(predicate func(val T) bool) (*SyncSet[T], *SyncSet[T]) {
+	resultMatch := NewSyncSet[T]()
+	resultNotMatch := NewSyncSet[T]()
+	for k := range s.All() {
+		if predicate(k) {
+			resultMatch.Add(k)
+		} else {
+			resultNotMatch.Add(k)
+		}
+	}
+	return resultMatch, resultNotMatch
+}
+
+// MapSync converts one concurrency safe set to another, elements that map to the same value will be deduped
+func MapSync[T, U comparable](source *SyncSet[U], selector func(U) T) *SyncSet[T] {
+	result := NewSyncSet[T]()
+	for k := range source.All() {
+		result.Add(selector(k))
+	}
+	return result
+}
+
+// ReduceSync will combine all elements of a concurrency safe set into a single value, see Reduce
+func ReduceSync[T comparable, U any](source *SyncSet[T], initial U, accumulator func(acc U, val T) U) U {
+	return Reduce(source.Snapshot(), initial, accumulator)
+}
+
+// MinBySync will return the element of a concurrency safe set with the smallest key, see MinBy
+func MinBySync[T comparable, K constraints.Ordered](source *SyncSet[T], key func(T) K) (T, bool) {
+	return MinBy(source.Snapshot(), key)
+}
+
+// MaxBySync will return the element of a concurrency safe set with the largest key, see MaxBy
+func MaxBySync[T comparable, K constraints.Ordered](source *SyncSet[T], key func(T) K) (T, bool) {
+	return MaxBy(source.Snapshot(), key)
+}
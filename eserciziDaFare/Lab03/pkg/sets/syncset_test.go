@@ -0,0 +1,95 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncSetConcurrentAddIfAbsent(t *testing.T) {
+	const workers = 16
+	const keys = 1000
+	s := NewSyncSet[int]()
+	var added sync.WaitGroup
+	results := make([]int, workers)
+	for w := range workers {
+		added.Add(1)
+		go func() {
+			defer added.Done()
+			for i := range keys {
+				if s.AddIfAbsent(i) {
+					results[w]++
+				}
+				if !s.IsMember(i) {
+					t.Errorf("%d missing right after being added", i)
+				}
+			}
+		}()
+	}
+	added.Wait()
+	total := 0
+	for _, r := range results {
+		total += r
+	}
+	if total != keys {
+		t.Errorf("AddIfAbsent returned true %d times, want %d", total, keys)
+	}
+	if s.Len() != keys {
+		t.Errorf("Len() = %d, want %d", s.Len(), keys)
+	}
+}
+
+func TestSyncSetConcurrentAddAndIterate(t *testing.T) {
+	const workers = 8
+	const perWorker = 500
+	s := NewSyncSet[int]()
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				s.Add(w*perWorker + i)
+			}
+		}()
+		go func() { //iterating while other goroutines write must not race
+			defer wg.Done()
+			for k := range s.All() {
+				if k < 0 || k >= workers*perWorker {
+					t.Errorf("unexpected element %d", k)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	count := 0
+	for range s.All() {
+		count++
+	}
+	if count != workers*perWorker {
+		t.Errorf("All() yielded %d elements, want %d", count, workers*perWorker)
+	}
+	if !s.Every(func(val int) bool { return s.IsMember(val) }) {
+		t.Error("Every(IsMember) = false")
+	}
+}
+
+func TestSyncSetHelpers(t *testing.T) {
+	s := NewSyncSetFromSeq(NewSetFromSlice([]int{1, 2, 3, 4, 5, 6}).All())
+	even, odd := s.Partition(func(val int) bool { return val%2 == 0 })
+	if even.Len() != 3 || odd.Len() != 3 || !even.IsMember(4) || !odd.IsMember(5) {
+		t.Errorf("Partition() = %v, %v", even.ToSlice(), odd.ToSlice())
+	}
+	halves := MapSync(s, func(val int) int { return val / 2 })
+	if halves.Len() != 4 {
+		t.Errorf("MapSync() has %d elements, want 4", halves.Len())
+	}
+	if sum := ReduceSync(s, 0, func(acc, val int) int { return acc + val }); sum != 21 {
+		t.Errorf("ReduceSync() = %d, want 21", sum)
+	}
+	if got, ok := MinBySync(s, func(val int) int { return -val }); !ok || got != 6 {
+		t.Errorf("MinBySync() = %d, %v", got, ok)
+	}
+	if got, ok := MaxBySync(NewSyncSet[int](), func(val int) int { return val }); ok || got != 0 {
+		t.Errorf("MaxBySync() on empty set = %d, %v", got, ok)
+	}
+}
@@ -0,0 +1,127 @@
+package slices
+
+import "constraints"
+
+// TryMax determines the maximum value in a slice of ordered values, false is returned for an empty slice
+func TryMax[T constraints.Ordered](source []T) (T, bool) {
+	if len(source) == 0 {
+		var blank T
+		return blank, false
+	}
+	return source[ArgMax(source)], true
+}
+
+// TryMin determines the minimum value in a slice of ordered values, false is returned for an empty slice
+func TryMin[T constraints.Ordered](source []T) (T, bool) {
+	if len(source) == 0 {
+		var blank T
+		return blank, false
+	}
+	return source[ArgMin(source)], true
+}
+
+// TryMinMax determines the minimum and maximum value in a slice of ordered values efficiently,
+// false is returned for an empty slice
+func TryMinMax[T constraints.Ordered](source []T) (T, T, bool) {
+	if len(source) == 0 {
+		var blank T
+		return blank, blank, false
+	}
+	minVal, maxVal := source[0], source[0]
+	for _, val := range source[1:] {
+		if val < minVal {
+			minVal = val
+		}
+		if val > maxVal {
+			maxVal = val
+		}
+	}
+	return minVal, maxVal, true
+}
+
+// ArgMax returns the index of the first occurrence of the maximum value, otherwise -1 for an empty slice
+func ArgMax[T constraints.Ordered](source []T) int {
+	return ArgMaxBy(source, func(x T) T { return x })
+}
+
+// ArgMin returns the index of the first occurrence of the minimum value, otherwise -1 for an empty slice
+func ArgMin[T constraints.Ordered](source []T) int {
+	return ArgMinBy(source, func(x T) T { return x })
+}
+
+// ArgMaxBy returns the index of the first element with the largest key, otherwise -1 for an empty slice
+func ArgMaxBy[T any, K constraints.Ordered](source []T, key func(T) K) int {
+	return argBest(source, key, func(a, b K) bool { return a > b })
+}
+
+// ArgMinBy returns the index of the first element with the smallest key, otherwise -1 for an empty slice
+func ArgMinBy[T any, K constraints.Ordered](source []T, key func(T) K) int {
+	return argBest(source, key, func(a, b K) bool { return a < b })
+}
+
+func argBest[T any, K constraints.Ordered](source []T, key func(T) K, better func(a, b K) bool) int {
+	bestIndex := -1
+	var bestKey K
+	for i, val := range source {
+		testKey := key(val)
+		if bestIndex == -1 || better(testKey, bestKey) {
+			bestIndex = i
+			bestKey = testKey
+		}
+	}
+	return bestIndex
+}
+
+// MaxBy returns the first element with the largest key, false is returned for an empty slice
+func MaxBy[T any, K constraints.Ordered](source []T, key func(T) K) (T, bool) {
+	i := ArgMaxBy(source, key)
+	if i == -1 {
+		var blank T
+		return blank, false
+	}
+	return source[i], true
+}
+
+// MinBy returns the first element with the smallest key, false is returned for an empty slice
+func MinBy[T any, K constraints.Ordered](source []T, key func(T) K) (T, bool) {
+	i := ArgMinBy(source, key)
+	if i == -1 {
+		var blank T
+		return blank, false
+	}
+	return source[i], true
+}
+
+// TopK returns the k largest values in descending order, or all values if there are fewer than k.
+// Uses a min heap bounded to k elements so runs in O(n log k)
+func TopK[T constraints.Ordered](source []T, k int) []T {
+	return boundedSelect(source, k, func(a, b T) bool { return a < b })
+}
+
+// BottomK returns the k smallest values in ascending order, or all values if there are fewer than k.
+// Uses a max heap bounded to k elements so runs in O(n log k)
+func BottomK[T constraints.Ordered](source []T, k int) []T {
+	return boundedSelect(source, k, func(a, b T) bool { return a > b })
+}
+
+// boundedSelect keeps the k best values in a heap whose root is the worst of them, evicting the
+// root whenever a better value arrives
+func boundedSelect[T constraints.Ordered](source []T, k int, worse func(a, b T) bool) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	h := newBinaryHeap(worse)
+	for _, val := range source {
+		if h.Len() < k {
+			h.Push(val)
+		} else if worse(h.Peek(), val) {
+			h.Pop()
+			h.Push(val)
+		}
+	}
+	result := make([]T, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = h.Pop()
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxMinNegative(t *testing.T) {
+	// The zero value must not win over values that are all below it
+	if got := Max([]int{-3, -1, -2}); got != -1 {
+		t.Errorf("Max() = %d, want -1", got)
+	}
+	if got := Min([]int{3, 1, 2}); got != 1 {
+		t.Errorf("Min() = %d, want 1", got)
+	}
+	if lo, hi := MinMax([]int{-5, -9, -2, -7}); lo != -9 || hi != -2 {
+		t.Errorf("MinMax() = %d, %d, want -9, -2", lo, hi)
+	}
+	if lo, hi := MinMax([]float64{-0.5}); lo != -0.5 || hi != -0.5 {
+		t.Errorf("MinMax() = %v, %v, want -0.5, -0.5", lo, hi)
+	}
+}
+
+func TestTryEmpty(t *testing.T) {
+	if got, ok := TryMax([]int{}); ok || got != 0 {
+		t.Errorf("TryMax() = %d, %v", got, ok)
+	}
+	if got, ok := TryMin[string](nil); ok || got != "" {
+		t.Errorf("TryMin() = %q, %v", got, ok)
+	}
+	if lo, hi, ok := TryMinMax([]int{}); ok || lo != 0 || hi != 0 {
+		t.Errorf("TryMinMax() = %d, %d, %v", lo, hi, ok)
+	}
+	if got, ok := MaxBy([]string{}, func(s string) int { return len(s) }); ok || got != "" {
+		t.Errorf("MaxBy() = %q, %v", got, ok)
+	}
+	if ArgMax([]int{}) != -1 || ArgMin([]int{}) != -1 {
+		t.Error("ArgMax/ArgMin of an empty slice should be -1")
+	}
+	if lo, hi, ok := TryMinMax([]int{4, -4, 0}); !ok || lo != -4 || hi != 4 {
+		t.Errorf("TryMinMax() = %d, %d, %v", lo, hi, ok)
+	}
+}
+
+func TestArgTiesPickFirst(t *testing.T) {
+	data := []int{2, 7, 1, 7, 1}
+	if got := ArgMax(data); got != 1 {
+		t.Errorf("ArgMax() = %d, want 1", got)
+	}
+	if got := ArgMin(data); got != 2 {
+		t.Errorf("ArgMin() = %d, want 2", got)
+	}
+	words := []string{"bb", "a", "cc", "d"}
+	if got, _ := MaxBy(words, func(s string) int { return len(s) }); got != "bb" {
+		t.Errorf("MaxBy() = %q, want bb", got)
+	}
+	if got, _ := MinBy(words, func(s string) int { return len(s) }); got != "a" {
+		t.Errorf("MinBy() = %q, want a", got)
+	}
+}
+
+func TestTopBottomK(t *testing.T) {
+	data := []int{5, 1, 9, 3, 9, 7, -2}
+	if got := TopK(data, 3); !reflect.DeepEqual(got, []int{9, 9, 7}) {
+		t.Errorf("TopK(3) = %v, want [9 9 7]", got)
+	}
+	if got := BottomK(data, 3); !reflect.DeepEqual(got, []int{-2, 1, 3}) {
+		t.Errorf("BottomK(3) = %v, want [-2 1 3]", got)
+	}
+	if got := TopK(data, 10); !reflect.DeepEqual(got, []int{9, 9, 7, 5, 3, 1, -2}) {
+		t.Errorf("TopK(10) = %v", got)
+	}
+	if got := BottomK(data, 0); len(got) != 0 {
+		t.Errorf("BottomK(0) = %v", got)
+	}
+	if !reflect.DeepEqual(data, []int{5, 1, 9, 3, 9, 7, -2}) {
+		t.Errorf("source modified to %v", data)
+	}
+}
@@ -0,0 +1,193 @@
+package slices
+
+import (
+	"constraints"
+	"iter"
+)
+
+// The generators below yield the same buffer on every iteration to avoid allocating each
+// arrangement, the yielded slice must be copied if it is kept beyond the current iteration
+
+// Permutations yields every ordering of the slice using Heap's algorithm, which changes a single
+// pair of elements between consecutive permutations. Repeated elements produce repeated permutations
+func Permutations[T any](source []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		buf := make([]T, len(source))
+		copy(buf, source)
+		if !yield(buf) {
+			return
+		}
+		counters := make([]int, len(buf))
+		for i := 1; i < len(buf); {
+			if counters[i] < i {
+				if i%2 == 0 {
+					buf[0], buf[i] = buf[i], buf[0]
+				} else {
+					buf[counters[i]], buf[i] = buf[i], buf[counters[i]]
+				}
+				if !yield(buf) {
+					return
+				}
+				counters[i]++
+				i = 1
+			} else {
+				counters[i] = 0
+				i++
+			}
+		}
+	}
+}
+
+// NextPermutation rearranges the slice in place into the next lexicographically greater ordering,
+// returning false (and leaving the slice sorted ascending) when it was already the last ordering
+func NextPermutation[T constraints.Ordered](data []T) bool {
+	i := len(data) - 2
+	for i >= 0 && data[i] >= data[i+1] {
+		i--
+	}
+	if i >= 0 {
+		j := len(data) - 1
+		for data[j] <= data[i] {
+			j--
+		}
+		data[i], data[j] = data[j], data[i]
+	}
+	for lo, hi := i+1, len(data)-1; lo < hi; lo, hi = lo+1, hi-1 {
+		data[lo], data[hi] = data[hi], data[lo]
+	}
+	return i >= 0
+}
+
+// LexicographicPermutations yields the distinct orderings of the slice in ascending lexicographic order,
+// so repeated elements do not produce repeated permutations
+func LexicographicPermutations[T constraints.Ordered](source []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		buf := make([]T, len(source))
+		copy(buf, source)
+		InsertionSort(buf, nil)
+		for {
+			if !yield(buf) || !NextPermutation(buf) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations yields every selection of k elements from the slice, keeping their original order
+func Combinations[T any](source []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(source)
+		if k < 0 || k > n {
+			return
+		}
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		buf := make([]T, k)
+		for {
+			for i, index := range indices {
+				buf[i] = source[index]
+			}
+			if !yield(buf) {
+				return
+			}
+			// Advance the rightmost index that has not reached its final position
+			i := k - 1
+			for i >= 0 && indices[i] == i+n-k {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement yields every selection of k elements from the slice where each
+// element may be chosen more than once, keeping their original order
+func CombinationsWithReplacement[T any](source []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(source)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+		indices := make([]int, k)
+		buf := make([]T, k)
+		for {
+			for i, index := range indices {
+				buf[i] = source[index]
+			}
+			if !yield(buf) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[i]
+			}
+		}
+	}
+}
+
+// CartesianProduct yields every way of choosing one element from each of the slices, varying the
+// last slice fastest
+func CartesianProduct[T any](sources ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, source := range sources {
+			if len(source) == 0 {
+				return
+			}
+		}
+		indices := make([]int, len(sources))
+		buf := make([]T, len(sources))
+		for {
+			for i, index := range indices {
+				buf[i] = sources[i][index]
+			}
+			if !yield(buf) {
+				return
+			}
+			i := len(sources) - 1
+			for i >= 0 && indices[i] == len(sources[i])-1 {
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+		}
+	}
+}
+
+// PowerSet yields every subset of the slice, keeping the original order of elements within each subset
+func PowerSet[T any](source []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(source) >= 63 {
+			panic("power set of 63 or more elements is not supported")
+		}
+		buf := make([]T, 0, len(source))
+		for mask := uint64(0); mask < 1<<len(source); mask++ {
+			buf = buf[:0]
+			for i, entry := range source {
+				if mask&(1<<i) != 0 {
+					buf = append(buf, entry)
+				}
+			}
+			if !yield(buf) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package slices
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"testing"
+)
+
+// collect copies every yielded buffer as the generators reuse it
+func collect[T any](seq iter.Seq[[]T]) [][]T {
+	result := [][]T{}
+	for buf := range seq {
+		result = append(result, append([]T{}, buf...))
+	}
+	return result
+}
+
+func TestPermutations(t *testing.T) {
+	perms := collect(Permutations([]int{1, 2, 3, 4}))
+	if len(perms) != 24 {
+		t.Fatalf("got %d permutations, want 24", len(perms))
+	}
+	seen := make(map[string]bool)
+	for i, p := range perms {
+		seen[fmt.Sprint(p)] = true
+		if i == 0 {
+			continue
+		}
+		changed := 0
+		for j := range p {
+			if p[j] != perms[i-1][j] {
+				changed++
+			}
+		}
+		if changed != 2 {
+			t.Errorf("permutation %v differs from %v in %d places, want a single swap", p, perms[i-1], changed)
+		}
+	}
+	if len(seen) != 24 {
+		t.Errorf("got %d distinct permutations, want 24", len(seen))
+	}
+	if got := collect(Permutations([]int{})); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("Permutations() of an empty slice = %v, want one empty permutation", got)
+	}
+}
+
+func TestLexicographicPermutations(t *testing.T) {
+	got := collect(LexicographicPermutations([]int{2, 1, 1}))
+	want := [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LexicographicPermutations() = %v, want %v", got, want)
+	}
+	last := []int{3, 2, 1}
+	if NextPermutation(last) || !reflect.DeepEqual(last, []int{1, 2, 3}) {
+		t.Errorf("NextPermutation() of the last ordering left %v", last)
+	}
+}
+
+func TestCombinationCounts(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5}
+	cases := []struct {
+		name string
+		seq  iter.Seq[[]int]
+		want int
+	}{
+		{"Combinations 5 choose 2", Combinations(source, 2), 10},
+		{"Combinations 5 choose 5", Combinations(source, 5), 1},
+		{"Combinations 5 choose 0", Combinations(source, 0), 1},
+		{"Combinations 5 choose 6", Combinations(source, 6), 0},
+		{"Combinations 5 choose -1", Combinations(source, -1), 0},
+		{"Combinations of empty choose 0", Combinations([]int{}, 0), 1},
+		{"CombinationsWithReplacement 5 choose 3", CombinationsWithReplacement(source, 3), 35},
+		{"CombinationsWithReplacement 5 choose 0", CombinationsWithReplacement(source, 0), 1},
+		{"CombinationsWithReplacement of empty choose 0", CombinationsWithReplacement([]int{}, 0), 1},
+		{"CombinationsWithReplacement of empty choose 2", CombinationsWithReplacement([]int{}, 2), 0},
+		{"CartesianProduct 2x3x2", CartesianProduct([]int{1, 2}, []int{3, 4, 5}, []int{6, 7}), 12},
+		{"CartesianProduct with an empty slice", CartesianProduct([]int{1, 2}, []int{}), 0},
+		{"CartesianProduct of nothing", CartesianProduct[int](), 1},
+		{"PowerSet of 5", PowerSet(source), 32},
+		{"PowerSet of empty", PowerSet([]int{}), 1},
+	}
+	for _, c := range cases {
+		if got := len(collect(c.seq)); got != c.want {
+			t.Errorf("%s yielded %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestCombinationOrder(t *testing.T) {
+	got := collect(Combinations([]string{"a", "b", "c", "d"}, 2))
+	want := [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Combinations() = %v", got)
+	}
+	got = collect(CombinationsWithReplacement([]string{"a", "b"}, 2))
+	want = [][]string{{"a", "a"}, {"a", "b"}, {"b", "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CombinationsWithReplacement() = %v", got)
+	}
+	got = collect(CartesianProduct([]string{"a", "b"}, []string{"x", "y"}))
+	want = [][]string{{"a", "x"}, {"a", "y"}, {"b", "x"}, {"b", "y"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CartesianProduct() = %v", got)
+	}
+	got = collect(PowerSet([]string{"a", "b"}))
+	want = [][]string{{}, {"a"}, {"b"}, {"a", "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PowerSet() = %v", got)
+	}
+}
+
+func TestGeneratorsStopEarly(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6}
+	generators := map[string]iter.Seq[[]int]{
+		"Permutations":                Permutations(source),
+		"LexicographicPermutations":   LexicographicPermutations(source),
+		"Combinations":                Combinations(source, 3),
+		"CombinationsWithReplacement": CombinationsWithReplacement(source, 3),
+		"CartesianProduct":            CartesianProduct(source, source),
+		"PowerSet":                    PowerSet(source),
+	}
+	for name, seq := range generators {
+		count := 0
+		for range seq {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		if count != 3 {
+			t.Errorf("%s yielded %d before stopping, want 3", name, count)
+		}
+	}
+}
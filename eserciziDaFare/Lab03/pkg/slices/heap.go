@@ -0,0 +1,58 @@
+package slices
+
+// binaryHeap is a minimal binary heap ordered by less, the root being the element that is
+// less than all others
+type binaryHeap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+func newBinaryHeap[T any](less func(a, b T) bool) *binaryHeap[T] {
+	return &binaryHeap[T]{less: less}
+}
+
+func (h *binaryHeap[T]) Len() int {
+	return len(h.data)
+}
+
+// Peek returns the root without removing it, the heap must not be empty
+func (h *binaryHeap[T]) Peek() T {
+	return h.data[0]
+}
+
+func (h *binaryHeap[T]) Push(val T) {
+	h.data = append(h.data, val)
+	i := len(h.data) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// Pop removes and returns the root, the heap must not be empty
+func (h *binaryHeap[T]) Pop() T {
+	root := h.data[0]
+	last := len(h.data) - 1
+	h.data[0] = h.data[last]
+	h.data = h.data[:last]
+	i := 0
+	for {
+		child := 2*i + 1
+		if child >= last {
+			break
+		}
+		if child+1 < last && h.less(h.data[child+1], h.data[child]) {
+			child++
+		}
+		if !h.less(h.data[child], h.data[i]) {
+			break
+		}
+		h.data[i], h.data[child] = h.data[child], h.data[i]
+		i = child
+	}
+	return root
+}
@@ -0,0 +1,156 @@
+package slices
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// PanicError wraps a panic raised by a worker so it can be re-raised on the calling goroutine
+// together with the stack trace of the worker that panicked
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("worker panic: %v\n%s", p.Value, p.Stack)
+}
+
+// parallelFor runs op for every index in [0, n) using at most workers goroutines (GOMAXPROCS when
+// workers <= 0). The first error cancels the remaining work and is returned, the first panic is
+// re-raised as a *PanicError once all workers have stopped
+func parallelFor(ctx context.Context, n, workers int, op func(ctx context.Context, i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next      atomic.Int64
+		wg        sync.WaitGroup
+		failOnce  sync.Once
+		firstErr  error
+		workPanic *PanicError
+	)
+	fail := func(err error, p *PanicError) {
+		failOnce.Do(func() {
+			firstErr = err
+			workPanic = p
+			cancel()
+		})
+	}
+	run := func(i int) {
+		defer func() {
+			if r := recover(); r != nil {
+				fail(nil, &PanicError{Value: r, Stack: debug.Stack()})
+			}
+		}()
+		if err := op(ctx, i); err != nil {
+			fail(err, nil)
+		}
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n || ctx.Err() != nil {
+					return
+				}
+				run(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if workPanic != nil {
+		panic(workPanic)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	// Only report cancellation that came from the caller's context
+	return context.Cause(ctx)
+}
+
+// ParallelForEach performs the operation on every element using a bounded pool of workers.
+// Set workers <= 0 to use GOMAXPROCS. The first error cancels the remaining work and is returned
+func ParallelForEach[T any](ctx context.Context, source []T, workers int, op func(ctx context.Context, val T) error) error {
+	return parallelFor(ctx, len(source), workers, func(ctx context.Context, i int) error {
+		return op(ctx, source[i])
+	})
+}
+
+// ParallelMap converts one slice to another using a bounded pool of workers, preserving the input order.
+// Set workers <= 0 to use GOMAXPROCS. The first error cancels the remaining work and is returned
+func ParallelMap[T, U any](ctx context.Context, source []U, workers int, selector func(ctx context.Context, val U) (T, error)) ([]T, error) {
+	result := make([]T, len(source))
+	err := parallelFor(ctx, len(source), workers, func(ctx context.Context, i int) error {
+		val, err := selector(ctx, source[i])
+		result[i] = val
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParallelFilter reduces a slice of elements based on the provided predicate using a bounded pool of
+// workers, preserving the input order. Set workers <= 0 to use GOMAXPROCS. The first error cancels
+// the remaining work and is returned
+func ParallelFilter[T any](ctx context.Context, source []T, workers int, predicate func(ctx context.Context, val T) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, source, workers, predicate)
+	if err != nil {
+		return nil, err
+	}
+	result := []T{}
+	for i, entry := range source {
+		if keep[i] {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// ParallelReduce combines all elements into a single value using a bounded pool of workers. The slice
+// is split into one contiguous chunk per worker, each chunk is accumulated from identity and the chunk
+// results are merged in order with combine, so combine must be associative with identity as its identity.
+// Set workers <= 0 to use GOMAXPROCS. The first error cancels the remaining work and is returned
+func ParallelReduce[T, U any](ctx context.Context, source []T, workers int, identity U,
+	accumulator func(ctx context.Context, acc U, val T) (U, error), combine func(a, b U) U) (U, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunks := Chunk(source, max(1, (len(source)+workers-1)/workers))
+	partials, err := ParallelMap(ctx, chunks, workers, func(ctx context.Context, chunk []T) (U, error) {
+		acc := identity
+		for _, entry := range chunk {
+			if err := ctx.Err(); err != nil {
+				return acc, err
+			}
+			var err error
+			if acc, err = accumulator(ctx, acc, entry); err != nil {
+				return acc, err
+			}
+		}
+		return acc, nil
+	})
+	if err != nil {
+		return identity, err
+	}
+	result := identity
+	for _, partial := range partials {
+		result = combine(result, partial)
+	}
+	return result, nil
+}
@@ -0,0 +1,140 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMapOrder(t *testing.T) {
+	source := make([]int, 200)
+	for i := range source {
+		source[i] = i
+	}
+	for _, workers := range []int{0, 1, 3, 500} {
+		got, err := ParallelMap(context.Background(), source, workers, func(ctx context.Context, val int) (string, error) {
+			time.Sleep(time.Duration(val%7) * time.Microsecond) //finish out of order
+			return strconv.Itoa(val), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, val := range got {
+			if val != strconv.Itoa(i) {
+				t.Fatalf("workers=%d: result[%d] = %s", workers, i, val)
+			}
+		}
+	}
+	evens, err := ParallelFilter(context.Background(), source, 4, func(ctx context.Context, val int) (bool, error) {
+		return val%2 == 0, nil
+	})
+	if err != nil || len(evens) != 100 {
+		t.Fatalf("ParallelFilter() = %d elements, %v", len(evens), err)
+	}
+	for i, val := range evens {
+		if val != 2*i {
+			t.Fatalf("ParallelFilter()[%d] = %d", i, val)
+		}
+	}
+	if got, err := ParallelMap(context.Background(), []int{}, 4, func(ctx context.Context, val int) (int, error) { return val, nil }); err != nil || len(got) != 0 {
+		t.Errorf("ParallelMap() of no data = %v, %v", got, err)
+	}
+}
+
+func TestParallelFirstErrorCancels(t *testing.T) {
+	errBoom := errors.New("boom")
+	var started atomic.Int64
+	source := make([]int, 1000)
+	for i := range source {
+		source[i] = i
+	}
+	err := ParallelForEach(context.Background(), source, 4, func(ctx context.Context, val int) error {
+		started.Add(1)
+		if val == 0 {
+			return errBoom
+		}
+		<-ctx.Done() //every other worker waits until the error cancels it
+		return ctx.Err()
+	})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("ParallelForEach() = %v, want the first error", err)
+	}
+	if n := started.Load(); n > 4 {
+		t.Errorf("%d operations started after the error, want at most one per worker", n)
+	}
+}
+
+func TestParallelCallerCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var done atomic.Int64
+	source := make([]int, 1000)
+	err := ParallelForEach(ctx, source, 2, func(ctx context.Context, val int) error {
+		if done.Add(1) == 10 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelForEach() = %v, want context.Canceled", err)
+	}
+	if n := done.Load(); n >= 1000 {
+		t.Errorf("all %d operations ran after cancellation", n)
+	}
+
+	_, err = ParallelReduce(ctx, source, 2, 0, func(ctx context.Context, acc, val int) (int, error) {
+		t.Error("accumulator called with a cancelled context")
+		return acc, nil
+	}, func(a, b int) int { return a + b })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelReduce() with a cancelled context = %v", err)
+	}
+}
+
+func TestParallelPanic(t *testing.T) {
+	defer func() {
+		r := recover()
+		p, ok := r.(*PanicError)
+		if !ok {
+			t.Fatalf("recovered %v, want a *PanicError", r)
+		}
+		if p.Value != "worker 3 failed" || len(p.Stack) == 0 {
+			t.Errorf("PanicError = %v with %d bytes of stack", p.Value, len(p.Stack))
+		}
+	}()
+	ParallelForEach(context.Background(), []int{1, 2, 3, 4, 5}, 3, func(ctx context.Context, val int) error {
+		if val == 3 {
+			panic(fmt.Sprintf("worker %d failed", val))
+		}
+		return nil
+	})
+	t.Error("ParallelForEach() returned after a worker panic")
+}
+
+func TestParallelReduce(t *testing.T) {
+	concat := func(ctx context.Context, acc string, val int) (string, error) { return acc + strconv.Itoa(val), nil }
+	join := func(a, b string) string { return a + b }
+	if got, err := ParallelReduce(context.Background(), []int{}, 4, "", concat, join); err != nil || got != "" {
+		t.Errorf("ParallelReduce() of no data = %q, %v", got, err)
+	}
+	// Concatenation is associative but not commutative, so the chunks must merge in order
+	source := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, workers := range []int{1, 3, 4, 7, 20} {
+		if got, err := ParallelReduce(context.Background(), source, workers, "", concat, join); err != nil || got != "0123456789" {
+			t.Errorf("workers=%d: ParallelReduce() = %q, %v", workers, got, err)
+		}
+	}
+	errOdd := errors.New("odd")
+	_, err := ParallelReduce(context.Background(), source, 3, 0, func(ctx context.Context, acc, val int) (int, error) {
+		if val == 7 {
+			return acc, errOdd
+		}
+		return acc + val, nil
+	}, func(a, b int) int { return a + b })
+	if !errors.Is(err, errOdd) {
+		t.Errorf("ParallelReduce() = %v, want the accumulator error", err)
+	}
+}
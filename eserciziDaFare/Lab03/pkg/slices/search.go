@@ -0,0 +1,107 @@
+package slices
+
+import "constraints"
+
+// PartitionPoint returns the index of the first element for which the predicate is false, assuming
+// the slice is partitioned so the predicate holds for a prefix and fails for the rest
+func PartitionPoint[T any](source []T, predicate func(T) bool) int {
+	lo, hi := 0, len(source)
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if predicate(source[mid]) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// LowerBound returns the index of the first element in the sorted slice that is not less than target,
+// or the length of the slice if there is none
+func LowerBound[T constraints.Ordered](sorted []T, target T) int {
+	return PartitionPoint(sorted, func(x T) bool { return x < target })
+}
+
+// UpperBound returns the index of the first element in the sorted slice that is greater than target,
+// or the length of the slice if there is none
+func UpperBound[T constraints.Ordered](sorted []T, target T) int {
+	return PartitionPoint(sorted, func(x T) bool { return x <= target })
+}
+
+// EqualRange returns the half open range of indices in the sorted slice whose elements equal target
+func EqualRange[T constraints.Ordered](sorted []T, target T) (int, int) {
+	return LowerBound(sorted, target), UpperBound(sorted, target)
+}
+
+// BinarySearch returns the index of the first occurrence of target in the sorted slice and true,
+// otherwise the index where it would be inserted and false
+func BinarySearch[T constraints.Ordered](sorted []T, target T) (int, bool) {
+	i := LowerBound(sorted, target)
+	return i, i < len(sorted) && sorted[i] == target
+}
+
+// SearchMin returns the smallest x in [lo, hi] for which the predicate holds, assuming the predicate
+// is monotone (false up to some point and true from then on). False is returned if it never holds
+func SearchMin[T constraints.Integer](lo, hi T, predicate func(T) bool) (T, bool) {
+	if lo > hi {
+		return lo, false
+	}
+	first, last := lo, hi
+	for first < last {
+		mid := midpointDown(first, last)
+		if predicate(mid) {
+			last = mid
+		} else {
+			first = mid + 1
+		}
+	}
+	return first, predicate(first)
+}
+
+// SearchMax returns the largest x in [lo, hi] for which the predicate holds, assuming the predicate
+// is monotone (true up to some point and false from then on). False is returned if it never holds
+func SearchMax[T constraints.Integer](lo, hi T, predicate func(T) bool) (T, bool) {
+	if lo > hi {
+		return hi, false
+	}
+	first, last := lo, hi
+	for first < last {
+		// Round up so the range always shrinks when first moves to mid
+		mid := midpointUp(first, last)
+		if predicate(mid) {
+			first = mid
+		} else {
+			last = mid - 1
+		}
+	}
+	return first, predicate(first)
+}
+
+// MinimizeConvex returns the x in [lo, hi] minimising a convex (decreasing then increasing) cost
+// function, such as the fuel needed to align every crab at position x, and the minimum cost
+func MinimizeConvex[T constraints.Integer, U constraints.Ordered](lo, hi T, cost func(T) U) (T, U) {
+	if lo > hi {
+		panic("minimise over an empty range is not supported")
+	}
+	if lo == hi {
+		return lo, cost(lo)
+	}
+	// The cost stops decreasing at the minimum, which makes the comparison with x+1 monotone
+	x, ok := SearchMin(lo, hi-1, func(x T) bool { return cost(x) <= cost(x+1) })
+	if !ok {
+		x = hi
+	}
+	return x, cost(x)
+}
+
+// midpointDown returns the average of a and b rounded down. last-first overflows when the
+// range spans more than half of T, so the average is built from the shared and differing bits
+func midpointDown[T constraints.Integer](a, b T) T {
+	return (a & b) + (a^b)>>1
+}
+
+// midpointUp returns the average of a and b rounded up, see midpointDown
+func midpointUp[T constraints.Integer](a, b T) T {
+	return (a | b) - (a^b)>>1
+}
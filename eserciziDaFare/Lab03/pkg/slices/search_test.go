@@ -0,0 +1,51 @@
+package slices
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSearchFullRange(t *testing.T) {
+	if got, ok := SearchMin(-1<<62, 1<<62, func(x int) bool { return x >= 12345 }); !ok || got != 12345 {
+		t.Errorf("SearchMin() = %d, %v, want 12345", got, ok)
+	}
+	if got, ok := SearchMin(math.MinInt64, math.MaxInt64, func(x int64) bool { return x >= -7 }); !ok || got != -7 {
+		t.Errorf("SearchMin() = %d, %v, want -7", got, ok)
+	}
+	if got, ok := SearchMax(math.MinInt64, math.MaxInt64, func(x int64) bool { return x <= math.MaxInt64-1 }); !ok || got != math.MaxInt64-1 {
+		t.Errorf("SearchMax() = %d, %v", got, ok)
+	}
+	for target := math.MinInt8; target <= math.MaxInt8; target++ {
+		got, ok := SearchMin(int8(math.MinInt8), int8(math.MaxInt8), func(x int8) bool { return int(x) >= target })
+		if !ok || int(got) != target {
+			t.Fatalf("SearchMin(int8) = %d, %v, want %d", got, ok, target)
+		}
+		got, ok = SearchMax(int8(math.MinInt8), int8(math.MaxInt8), func(x int8) bool { return int(x) <= target })
+		if !ok || int(got) != target {
+			t.Fatalf("SearchMax(int8) = %d, %v, want %d", got, ok, target)
+		}
+	}
+	if got, ok := SearchMin(uint64(0), math.MaxUint64, func(x uint64) bool { return x >= math.MaxUint64-3 }); !ok || got != math.MaxUint64-3 {
+		t.Errorf("SearchMin(uint64) = %d, %v", got, ok)
+	}
+}
+
+func TestMinimizeConvex(t *testing.T) {
+	crabs := []int{16, 1, 2, 0, 4, 2, 7, 1, 2, 14}
+	cost := func(x int) int {
+		total := 0
+		for _, c := range crabs {
+			total += max(c-x, x-c)
+		}
+		return total
+	}
+	if x, c := MinimizeConvex(0, 16, cost); x != 2 || c != 37 {
+		t.Errorf("MinimizeConvex() = %d, %d, want 2, 37", x, c)
+	}
+	if x, _ := MinimizeConvex(-1<<62, 1<<62, func(x int) int { return max(x-100, 100-x) }); x != 100 {
+		t.Errorf("MinimizeConvex() = %d, want 100", x)
+	}
+	if x, _ := MinimizeConvex(int8(math.MinInt8), int8(math.MinInt8), func(x int8) int { return int(x) }); x != math.MinInt8 {
+		t.Errorf("MinimizeConvex() on a single point = %d", x)
+	}
+}
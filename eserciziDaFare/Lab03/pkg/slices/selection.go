@@ -0,0 +1,220 @@
+package slices
+
+import (
+	"constraints"
+	"fmt"
+	"math/rand/v2"
+)
+
+// Number is satisfied by any integer or floating point type
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// KthSmallest returns the element that would be at index k (zero based) if the slice were sorted.
+// The source is not modified. Uses the median of medians pivot so runs in worst case linear time
+func KthSmallest[T constraints.Ordered](source []T, k int) T {
+	checkRank(len(source), k)
+	data := make([]T, len(source))
+	copy(data, source)
+	return selectMedianOfMedians(data, k)
+}
+
+// QuickSelect reorders the slice in place so the element at index k is the one that would be there
+// if the slice were sorted, with no larger elements before it and no smaller elements after it.
+// Uses a random pivot so runs in expected linear time
+func QuickSelect[T constraints.Ordered](data []T, k int) T {
+	checkRank(len(data), k)
+	lo, hi := 0, len(data)
+	for hi-lo > 1 {
+		pivot := data[lo+rand.IntN(hi-lo)]
+		lt, gt := partition3(data[lo:hi], pivot)
+		switch {
+		case k < lo+lt:
+			hi = lo + lt
+		case k < lo+gt:
+			return data[k]
+		default:
+			lo += gt
+		}
+	}
+	return data[k]
+}
+
+func checkRank(n, k int) {
+	if k < 0 || k >= n {
+		panic(fmt.Sprintf("rank %d out of range for slice of length %d", k, n))
+	}
+}
+
+// partition3 reorders data into elements less than, equal to and greater than pivot, returning
+// the index of the first element equal to pivot and the index of the first element greater
+func partition3[T constraints.Ordered](data []T, pivot T) (int, int) {
+	lt, i, gt := 0, 0, len(data)
+	for i < gt {
+		switch {
+		case data[i] < pivot:
+			data[lt], data[i] = data[i], data[lt]
+			lt++
+			i++
+		case data[i] > pivot:
+			gt--
+			data[i], data[gt] = data[gt], data[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+func selectMedianOfMedians[T constraints.Ordered](data []T, k int) T {
+	for {
+		if len(data) <= 5 {
+			InsertionSort(data, nil)
+			return data[k]
+		}
+		// Move the median of each group of 5 to the front and find the median of those
+		numGroups := 0
+		for lo := 0; lo < len(data); lo += 5 {
+			group := data[lo:min(lo+5, len(data))]
+			InsertionSort(group, nil)
+			data[numGroups], group[len(group)/2] = group[len(group)/2], data[numGroups]
+			numGroups++
+		}
+		pivot := selectMedianOfMedians(data[:numGroups], numGroups/2)
+
+		lt, gt := partition3(data, pivot)
+		switch {
+		case k < lt:
+			data = data[:lt]
+		case k < gt:
+			return pivot
+		default:
+			data = data[gt:]
+			k -= gt
+		}
+	}
+}
+
+// MedianLower returns the median of the slice, choosing the lower middle element for even lengths
+func MedianLower[T constraints.Ordered](source []T) T {
+	return KthSmallest(source, (len(source)-1)/2)
+}
+
+// MedianUpper returns the median of the slice, choosing the upper middle element for even lengths
+func MedianUpper[T constraints.Ordered](source []T) T {
+	return KthSmallest(source, len(source)/2)
+}
+
+// MedianAverage returns the median of the slice, averaging the two middle elements for even lengths
+func MedianAverage[T Number](source []T) float64 {
+	n := len(source)
+	checkRank(n, 0)
+	data := make([]T, n)
+	copy(data, source)
+	upper := selectMedianOfMedians(data, n/2)
+	if n%2 == 1 {
+		return float64(upper)
+	}
+	// After selection everything before n/2 is no larger than upper, so the lower middle is its maximum
+	lower := data[0]
+	for _, val := range data[1 : n/2] {
+		if val > lower {
+			lower = val
+		}
+	}
+	return (float64(lower) + float64(upper)) / 2
+}
+
+// Quantile returns the q-th quantile (0 <= q <= 1) of the slice, linearly interpolating between
+// the closest ranks when it falls between two elements
+func Quantile[T Number](source []T, q float64) float64 {
+	return Quantiles(source, q)[0]
+}
+
+// Quantiles returns the quantiles of the slice for each of qs, sorting a copy of the slice only once
+func Quantiles[T Number](source []T, qs ...float64) []float64 {
+	checkRank(len(source), 0)
+	sorted := make([]T, len(source))
+	copy(sorted, source)
+	QuickSortRandom(sorted, nil)
+
+	result := make([]float64, len(qs))
+	for i, q := range qs {
+		if !(q >= 0 && q <= 1) { // also rejects NaN
+			panic(fmt.Sprintf("quantile %v out of range [0, 1]", q))
+		}
+		pos := q * float64(len(sorted)-1)
+		lo := int(pos)
+		hi := min(lo+1, len(sorted)-1)
+		frac := pos - float64(lo)
+		result[i] = float64(sorted[lo]) + frac*(float64(sorted[hi])-float64(sorted[lo]))
+	}
+	return result
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of the slice, see Quantile
+func Percentile[T Number](source []T, p float64) float64 {
+	return Quantile(source, p/100)
+}
+
+// StreamingMedian tracks the median of a stream of values using two heaps, the lower half in a max
+// heap and the upper half in a min heap. Adding a value is O(log n) and reading the median is O(1)
+type StreamingMedian[T Number] struct {
+	lower *binaryHeap[T]
+	upper *binaryHeap[T]
+}
+
+// NewStreamingMedian creates an empty streaming median
+func NewStreamingMedian[T Number]() *StreamingMedian[T] {
+	return &StreamingMedian[T]{
+		lower: newBinaryHeap(func(a, b T) bool { return a > b }),
+		upper: newBinaryHeap(func(a, b T) bool { return a < b }),
+	}
+}
+
+// Add will add a value to the stream
+func (s *StreamingMedian[T]) Add(val T) {
+	if s.lower.Len() == 0 || val <= s.lower.Peek() {
+		s.lower.Push(val)
+	} else {
+		s.upper.Push(val)
+	}
+	// Keep the lower half the same size as the upper half or one larger
+	if s.lower.Len() > s.upper.Len()+1 {
+		s.upper.Push(s.lower.Pop())
+	} else if s.upper.Len() > s.lower.Len() {
+		s.lower.Push(s.upper.Pop())
+	}
+}
+
+// Len returns the number of values added to the stream
+func (s *StreamingMedian[T]) Len() int {
+	return s.lower.Len() + s.upper.Len()
+}
+
+// MedianLower returns the median so far, choosing the lower middle value for even counts
+func (s *StreamingMedian[T]) MedianLower() T {
+	s.checkNotEmpty()
+	return s.lower.Peek()
+}
+
+// MedianUpper returns the median so far, choosing the upper middle value for even counts
+func (s *StreamingMedian[T]) MedianUpper() T {
+	s.checkNotEmpty()
+	if s.upper.Len() == s.lower.Len() {
+		return s.upper.Peek()
+	}
+	return s.lower.Peek()
+}
+
+// Median returns the median so far, averaging the two middle values for even counts
+func (s *StreamingMedian[T]) Median() float64 {
+	return (float64(s.MedianLower()) + float64(s.MedianUpper())) / 2
+}
+
+func (s *StreamingMedian[T]) checkNotEmpty() {
+	if s.Len() == 0 {
+		panic("median of empty stream is not defined")
+	}
+}
@@ -0,0 +1,125 @@
+package slices
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func randomInts(rng *rand.Rand, n, spread int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = rng.IntN(spread) - spread/2
+	}
+	return data
+}
+
+func sortedCopy(data []int) []int {
+	sorted := append([]int{}, data...)
+	sort.Ints(sorted)
+	return sorted
+}
+
+func TestSelectionMatchesSort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(23, 24))
+	for _, n := range []int{1, 2, 5, 6, 7, 26, 100, 101} {
+		for _, spread := range []int{3, 1000} { //duplicate heavy and mostly distinct
+			data := randomInts(rng, n, spread)
+			original := append([]int{}, data...)
+			sorted := sortedCopy(data)
+			for k := range n {
+				if got := KthSmallest(data, k); got != sorted[k] {
+					t.Fatalf("n=%d: KthSmallest(%d) = %d, want %d", n, k, got, sorted[k])
+				}
+				work := append([]int{}, data...)
+				if got := QuickSelect(work, k); got != sorted[k] || work[k] != sorted[k] {
+					t.Fatalf("n=%d: QuickSelect(%d) = %d, want %d", n, k, got, sorted[k])
+				}
+				for i := range work {
+					if (i < k && work[i] > work[k]) || (i > k && work[i] < work[k]) {
+						t.Fatalf("n=%d: QuickSelect(%d) left %d at %d on the wrong side", n, k, work[i], i)
+					}
+				}
+			}
+			if !reflect.DeepEqual(data, original) {
+				t.Fatal("KthSmallest modified its source")
+			}
+			if got := MedianLower(data); got != sorted[(n-1)/2] {
+				t.Errorf("n=%d: MedianLower() = %d, want %d", n, got, sorted[(n-1)/2])
+			}
+			if got := MedianUpper(data); got != sorted[n/2] {
+				t.Errorf("n=%d: MedianUpper() = %d, want %d", n, got, sorted[n/2])
+			}
+			want := float64(sorted[(n-1)/2]+sorted[n/2]) / 2
+			if got := MedianAverage(data); got != want {
+				t.Errorf("n=%d: MedianAverage() = %v, want %v", n, got, want)
+			}
+		}
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	data := []int{15, 20, 35, 40, 50}
+	got := Quantiles(data, 0, 0.25, 0.5, 0.75, 1, 0.1)
+	want := []float64{15, 20, 35, 40, 50, 17}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Quantiles() = %v, want %v", got, want)
+	}
+	if got := Quantile([]float64{1, 2, 3, 4}, 0.5); got != 2.5 {
+		t.Errorf("Quantile(0.5) of an even length = %v, want 2.5", got)
+	}
+	if got := Percentile([]int{7, 7, 7, 1}, 50); got != 7 {
+		t.Errorf("Percentile(50) = %v, want 7", got)
+	}
+	rng := rand.New(rand.NewPCG(25, 26))
+	for range 50 {
+		data := randomInts(rng, 1+rng.IntN(40), 10)
+		sorted := sortedCopy(data)
+		q := rng.Float64()
+		pos := q * float64(len(sorted)-1)
+		lo, hi := int(math.Floor(pos)), int(math.Ceil(pos))
+		want := float64(sorted[lo]) + (pos-float64(lo))*float64(sorted[hi]-sorted[lo])
+		if got := Quantile(data, q); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("Quantile(%v) of %v = %v, want %v", q, sorted, got, want)
+		}
+	}
+}
+
+func TestQuantileOutOfRangePanics(t *testing.T) {
+	for _, q := range []float64{-0.1, 1.5, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				r := recover()
+				if msg, ok := r.(string); !ok || !strings.Contains(msg, "out of range [0, 1]") {
+					t.Errorf("Quantile(%v) panicked with %v", q, r)
+				}
+			}()
+			Quantile([]int{1, 2, 3}, q)
+		}()
+	}
+}
+
+func TestStreamingMedian(t *testing.T) {
+	rng := rand.New(rand.NewPCG(27, 28))
+	s := NewStreamingMedian[int]()
+	var seen []int
+	for range 300 {
+		val := rng.IntN(20)
+		s.Add(val)
+		seen = append(seen, val)
+		sorted := sortedCopy(seen)
+		n := len(sorted)
+		if s.Len() != n {
+			t.Fatalf("Len() = %d, want %d", s.Len(), n)
+		}
+		if s.MedianLower() != sorted[(n-1)/2] || s.MedianUpper() != sorted[n/2] {
+			t.Fatalf("after %d values medians = %d, %d, want %d, %d", n, s.MedianLower(), s.MedianUpper(), sorted[(n-1)/2], sorted[n/2])
+		}
+		if want := float64(sorted[(n-1)/2]+sorted[n/2]) / 2; s.Median() != want {
+			t.Fatalf("Median() = %v, want %v", s.Median(), want)
+		}
+	}
+}
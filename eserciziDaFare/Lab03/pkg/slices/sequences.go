@@ -0,0 +1,159 @@
+package slices
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"fmt"
+)
+
+// Chunk will split the slice into consecutive chunks of size n, the final chunk may be shorter.
+// The chunks share memory with the source
+func Chunk[T any](source []T, n int) [][]T {
+	checkSize(n)
+	result := make([][]T, 0, (len(source)+n-1)/n)
+	for lo := 0; lo < len(source); lo += n {
+		hi := min(lo+n, len(source))
+		result = append(result, source[lo:hi:hi])
+	}
+	return result
+}
+
+// Windows returns every run of n consecutive elements in the slice, empty if the slice is shorter than n.
+// The windows share memory with the source
+func Windows[T any](source []T, n int) [][]T {
+	checkSize(n)
+	if len(source) < n {
+		return [][]T{}
+	}
+	result := make([][]T, len(source)-n+1)
+	for i := range result {
+		result[i] = source[i : i+n : i+n]
+	}
+	return result
+}
+
+func checkSize(n int) {
+	if n <= 0 {
+		panic(fmt.Sprintf("size must be positive, got %d", n))
+	}
+}
+
+// Pairwise returns every pair of adjacent elements in the slice
+func Pairwise[T any](source []T) []tuples.Pair[T, T] {
+	if len(source) < 2 {
+		return []tuples.Pair[T, T]{}
+	}
+	result := make([]tuples.Pair[T, T], len(source)-1)
+	for i := range result {
+		result[i] = tuples.Pair[T, T]{Key: source[i], Value: source[i+1]}
+	}
+	return result
+}
+
+// Flatten will concatenate a slice of slices into a single slice
+func Flatten[T any](source [][]T) []T {
+	size := 0
+	for _, part := range source {
+		size += len(part)
+	}
+	result := make([]T, 0, size)
+	for _, part := range source {
+		result = append(result, part...)
+	}
+	return result
+}
+
+// GroupBy will group the elements by the key returned from the selector, preserving their order within each group
+func GroupBy[T any, K comparable](source []T, selector func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, entry := range source {
+		key := selector(entry)
+		result[key] = append(result[key], entry)
+	}
+	return result
+}
+
+// Uniq will remove repeated elements, keeping the first occurrence of each
+func Uniq[T comparable](source []T) []T {
+	return UniqBy(source, func(x T) T { return x })
+}
+
+// UniqBy will remove elements whose key has already been seen, keeping the first occurrence of each key
+func UniqBy[T any, K comparable](source []T, selector func(T) K) []T {
+	seen := make(map[K]struct{})
+	result := []T{}
+	for _, entry := range source {
+		key := selector(entry)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// Frequencies counts the number of occurrences of each element
+func Frequencies[T comparable](source []T) map[T]int {
+	result := make(map[T]int)
+	for _, entry := range source {
+		result[entry]++
+	}
+	return result
+}
+
+// Scan returns the running accumulation of the slice, where element i is the accumulation of
+// initial with the first i+1 elements
+func Scan[T, U any](source []T, initial U, accumulator func(acc U, val T) U) []U {
+	result := make([]U, len(source))
+	acc := initial
+	for i, entry := range source {
+		acc = accumulator(acc, entry)
+		result[i] = acc
+	}
+	return result
+}
+
+// TakeWhile returns the longest prefix of the slice where every element matches the predicate
+func TakeWhile[T any](source []T, predicate func(T) bool) []T {
+	for i, entry := range source {
+		if !predicate(entry) {
+			return source[:i]
+		}
+	}
+	return source
+}
+
+// DropWhile returns the slice with the longest prefix matching the predicate removed
+func DropWhile[T any](source []T, predicate func(T) bool) []T {
+	return source[len(TakeWhile(source, predicate)):]
+}
+
+// Interleave will merge the slices by taking one element from each in turn, skipping slices that run out
+func Interleave[T any](sources ...[]T) []T {
+	size, longest := 0, 0
+	for _, source := range sources {
+		size += len(source)
+		longest = max(longest, len(source))
+	}
+	result := make([]T, 0, size)
+	for i := 0; i < longest; i++ {
+		for _, source := range sources {
+			if i < len(source) {
+				result = append(result, source[i])
+			}
+		}
+	}
+	return result
+}
+
+// Rotate returns a new slice with the elements shifted left by n places, wrapping around to the end.
+// Negative values of n shift right
+func Rotate[T any](source []T, n int) []T {
+	result := make([]T, len(source))
+	if len(source) == 0 {
+		return result
+	}
+	n = ((n % len(source)) + len(source)) % len(source)
+	copy(result, source[n:])
+	copy(result[len(source)-n:], source[:n])
+	return result
+}
@@ -0,0 +1,157 @@
+package slices
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7}
+	cases := []struct {
+		n    int
+		want [][]int
+	}{
+		{1, [][]int{{1}, {2}, {3}, {4}, {5}, {6}, {7}}},
+		{3, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{7, [][]int{{1, 2, 3, 4, 5, 6, 7}}},
+		{10, [][]int{{1, 2, 3, 4, 5, 6, 7}}},
+	}
+	for _, c := range cases {
+		if got := Chunk(data, c.n); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Chunk(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+	if got := Chunk([]int{}, 3); len(got) != 0 {
+		t.Errorf("Chunk() of an empty slice = %v", got)
+	}
+	// Chunks are capped so appending to one must not overwrite the next
+	chunks := Chunk(data, 3)
+	_ = append(chunks[0], 99)
+	if data[3] != 4 {
+		t.Errorf("appending to a chunk overwrote the source: %v", data)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	if got := Windows(data, 2); !reflect.DeepEqual(got, [][]int{{1, 2}, {2, 3}, {3, 4}}) {
+		t.Errorf("Windows(2) = %v", got)
+	}
+	if got := Windows(data, 4); !reflect.DeepEqual(got, [][]int{{1, 2, 3, 4}}) {
+		t.Errorf("Windows(4) = %v", got)
+	}
+	if got := Windows(data, 5); got == nil || len(got) != 0 {
+		t.Errorf("Windows(5) = %#v, want an empty slice", got)
+	}
+	_ = append(Windows(data, 2)[0], 99)
+	if data[2] != 3 {
+		t.Errorf("appending to a window overwrote the source: %v", data)
+	}
+}
+
+func TestSizePanics(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		for name, f := range map[string]func(){
+			"Chunk":   func() { Chunk([]int{1, 2}, n) },
+			"Windows": func() { Windows([]int{1, 2}, n) },
+		} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("%s(%d) did not panic", name, n)
+					}
+				}()
+				f()
+			}()
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	cases := []struct {
+		n    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{-5, []int{1, 2, 3, 4, 5}},
+		{-12, []int{4, 5, 1, 2, 3}},
+	}
+	for _, c := range cases {
+		if got := Rotate(data, c.n); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Rotate(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+	if !reflect.DeepEqual(data, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Rotate() modified its input: %v", data)
+	}
+	if got := Rotate([]int{}, -3); len(got) != 0 {
+		t.Errorf("Rotate() of an empty slice = %v", got)
+	}
+}
+
+func TestPairwiseAndFlatten(t *testing.T) {
+	want := []tuples.Pair[int, int]{tuples.NewPair(1, 2), tuples.NewPair(2, 3)}
+	if got := Pairwise([]int{1, 2, 3}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Pairwise() = %v", got)
+	}
+	if got := Pairwise([]int{1}); len(got) != 0 {
+		t.Errorf("Pairwise() of one element = %v", got)
+	}
+	if got := Flatten([][]int{{1, 2}, {}, {3}}); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Flatten() = %v", got)
+	}
+}
+
+func TestGroupingAndDedup(t *testing.T) {
+	words := []string{"bb", "a", "cc", "d", "a", "eee"}
+	groups := GroupBy(words, func(s string) int { return len(s) })
+	want := map[int][]string{1: {"a", "d", "a"}, 2: {"bb", "cc"}, 3: {"eee"}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+	if got := Uniq(words); !reflect.DeepEqual(got, []string{"bb", "a", "cc", "d", "eee"}) {
+		t.Errorf("Uniq() = %v", got)
+	}
+	if got := UniqBy(words, func(s string) int { return len(s) }); !reflect.DeepEqual(got, []string{"bb", "a", "eee"}) {
+		t.Errorf("UniqBy() = %v", got)
+	}
+	if got := Frequencies(words); !reflect.DeepEqual(got, map[string]int{"bb": 1, "a": 2, "cc": 1, "d": 1, "eee": 1}) {
+		t.Errorf("Frequencies() = %v", got)
+	}
+}
+
+func TestScanTakeDrop(t *testing.T) {
+	data := []int{1, 2, 3, 10, 4}
+	if got := Scan(data, 0, func(acc, v int) int { return acc + v }); !reflect.DeepEqual(got, []int{1, 3, 6, 16, 20}) {
+		t.Errorf("Scan() = %v", got)
+	}
+	small := func(v int) bool { return v < 5 }
+	if got := TakeWhile(data, small); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("TakeWhile() = %v", got)
+	}
+	if got := DropWhile(data, small); !reflect.DeepEqual(got, []int{10, 4}) {
+		t.Errorf("DropWhile() = %v", got)
+	}
+	if got := TakeWhile(data, func(int) bool { return true }); len(got) != len(data) {
+		t.Errorf("TakeWhile() of all matching = %v", got)
+	}
+	if got := DropWhile(data, func(int) bool { return true }); len(got) != 0 {
+		t.Errorf("DropWhile() of all matching = %v", got)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	got := Interleave([]int{1, 4, 6}, []int{}, []int{2}, []int{3, 5})
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Interleave() = %v", got)
+	}
+	if got := Interleave[int](); len(got) != 0 {
+		t.Errorf("Interleave() of nothing = %v", got)
+	}
+}
@@ -1,8 +1,8 @@
 package slices
 
 import (
+	"adventofcode2021/pkg/tuples"
 	"constraints"
-	"sort"
 )
 
 // Filter will reduce a slice of elements based on the provided predicate
@@ -95,46 +95,22 @@ func Map[T, U any](source []U, selector func(U) T) []T {
 	return result
 }
 
-// Max determines the maximum value in a slice of ordered values
+// Max determines the maximum value in a slice of ordered values, the zero value is returned for an empty slice
 func Max[T constraints.Ordered](source []T) T {
-	var maxVal T
-	for _, val := range source {
-		if val > maxVal {
-			maxVal = val
-		}
-	}
+	maxVal, _ := TryMax(source)
 	return maxVal
 }
 
-// Min determines the minimum value in a slice of ordered values
+// Min determines the minimum value in a slice of ordered values, the zero value is returned for an empty slice
 func Min[T constraints.Ordered](source []T) T {
-	var minVal T
-	if len(source) != 0 {
-		minVal = source[0]
-	}
-	for _, val := range source {
-		if val < minVal {
-			minVal = val
-		}
-	}
+	minVal, _ := TryMin(source)
 	return minVal
 }
 
-// MinMax determines the minimum and maximum value in a slice of ordered values efficiently
+// MinMax determines the minimum and maximum value in a slice of ordered values efficiently,
+// zero values are returned for an empty slice
 func MinMax[T constraints.Ordered](source []T) (T, T) {
-	var minVal T
-	var maxVal T
-	if len(source) != 0 {
-		minVal = source[0]
-	}
-	for _, val := range source {
-		if val < minVal {
-			minVal = val
-		}
-		if val > maxVal {
-			maxVal = val
-		}
-	}
+	minVal, maxVal, _ := TryMinMax(source)
 	return minVal, maxVal
 }
 
@@ -190,18 +166,14 @@ func TrimEnd[T any](source []T, n int) []T {
 	return source[0 : len(source)-n]
 }
 
-// Median will return the median value of odd length slices
+// Median will return the median value of odd length slices, see MedianLower, MedianUpper and
+// MedianAverage for even length slices
 func Median[T constraints.Integer](source []T) T {
 	n := len(source)
 	if n%2 == 0 {
 		panic("median of even slices is not supported")
 	}
-	result := make([]T, n)
-	copy(result, source)
-	sort.SliceStable(result, func(i, j int) bool {
-		return result[i] < result[j]
-	})
-	return result[n/2]
+	return KthSmallest(source, n/2)
 }
 
 // IndexOf returns the index where the first occurence of val is, otherwise -1 if not found
@@ -213,3 +185,33 @@ func IndexOf[T comparable](source []T, val T) int {
 	}
 	return -1
 }
+
+// Zip pairs up the elements of two slices by position, extra elements in the longer slice are ignored
+func Zip[T, U any](keys []T, values []U) []tuples.Pair[T, U] {
+	n := min(len(keys), len(values))
+	result := make([]tuples.Pair[T, U], n)
+	for i := 0; i < n; i++ {
+		result[i] = tuples.Pair[T, U]{Key: keys[i], Value: values[i]}
+	}
+	return result
+}
+
+// Unzip splits a slice of pairs into a slice of keys and a slice of values
+func Unzip[T, U any](source []tuples.Pair[T, U]) ([]T, []U) {
+	keys := make([]T, len(source))
+	values := make([]U, len(source))
+	for i, p := range source {
+		keys[i] = p.Key
+		values[i] = p.Value
+	}
+	return keys, values
+}
+
+// Enumerate pairs each element with its index in the slice
+func Enumerate[T any](source []T) []tuples.Pair[int, T] {
+	result := make([]tuples.Pair[int, T], len(source))
+	for i, val := range source {
+		result[i] = tuples.Pair[int, T]{Key: i, Value: val}
+	}
+	return result
+}
@@ -0,0 +1,388 @@
+package slices
+
+import (
+	"constraints"
+	"fmt"
+	"math/rand/v2"
+)
+
+// SortStats records the work done by a sorting algorithm. Every sort accepts a nil
+// *SortStats when no instrumentation is required
+type SortStats struct {
+	Comparisons int // number of comparisons between two elements
+	Swaps       int // number of exchanges of two elements
+	Moves       int // number of single element writes that are not part of a swap
+	Allocations int // number of auxiliary buffers allocated
+}
+
+// sorter wraps the primitive operations of the sorts so they can be counted
+type sorter[T constraints.Ordered] struct {
+	stats *SortStats
+}
+
+func (s sorter[T]) less(a, b T) bool {
+	if s.stats != nil {
+		s.stats.Comparisons++
+	}
+	return a < b
+}
+
+func (s sorter[T]) swap(data []T, i, j int) {
+	if s.stats != nil {
+		s.stats.Swaps++
+	}
+	data[i], data[j] = data[j], data[i]
+}
+
+func (s sorter[T]) move(data []T, i int, val T) {
+	if s.stats != nil {
+		s.stats.Moves++
+	}
+	data[i] = val
+}
+
+func alloc[T any](stats *SortStats, n int) []T {
+	if stats != nil {
+		stats.Allocations++
+	}
+	return make([]T, n)
+}
+
+// InsertionSort sorts the slice in place by inserting each element into the sorted prefix
+func InsertionSort[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	for i := 1; i < len(data); i++ {
+		val := data[i]
+		j := i
+		for j > 0 && s.less(val, data[j-1]) {
+			s.move(data, j, data[j-1])
+			j--
+		}
+		if j != i {
+			s.move(data, j, val)
+		}
+	}
+}
+
+// SelectionSort sorts the slice in place by repeatedly selecting the smallest remaining element
+func SelectionSort[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	for i := 0; i < len(data)-1; i++ {
+		minIndex := i
+		for j := i + 1; j < len(data); j++ {
+			if s.less(data[j], data[minIndex]) {
+				minIndex = j
+			}
+		}
+		if minIndex != i {
+			s.swap(data, i, minIndex)
+		}
+	}
+}
+
+// BubbleSort sorts the slice in place by swapping adjacent elements, stopping early once a pass makes no swaps
+func BubbleSort[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	for end := len(data) - 1; end > 0; end-- {
+		swapped := false
+		for i := 0; i < end; i++ {
+			if s.less(data[i+1], data[i]) {
+				s.swap(data, i, i+1)
+				swapped = true
+			}
+		}
+		if !swapped {
+			return
+		}
+	}
+}
+
+// ShellSort sorts the slice in place using insertion sort over decreasing gaps (Knuth's 3h+1 sequence)
+func ShellSort[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	gap := 1
+	for gap < len(data)/3 {
+		gap = 3*gap + 1
+	}
+	for ; gap > 0; gap /= 3 {
+		for i := gap; i < len(data); i++ {
+			val := data[i]
+			j := i
+			for j >= gap && s.less(val, data[j-gap]) {
+				s.move(data, j, data[j-gap])
+				j -= gap
+			}
+			if j != i {
+				s.move(data, j, val)
+			}
+		}
+	}
+}
+
+// merge combines the sorted runs data[lo:mid] and data[mid:hi] using buf as scratch space
+func (s sorter[T]) merge(data, buf []T, lo, mid, hi int) {
+	copy(buf[lo:hi], data[lo:hi])
+	i, j := lo, mid
+	for k := lo; k < hi; k++ {
+		switch {
+		case i >= mid:
+			s.move(data, k, buf[j])
+			j++
+		case j >= hi:
+			s.move(data, k, buf[i])
+			i++
+		case s.less(buf[j], buf[i]):
+			s.move(data, k, buf[j])
+			j++
+		default:
+			s.move(data, k, buf[i])
+			i++
+		}
+	}
+}
+
+// MergeSort sorts the slice in place using a stable recursive top-down merge sort
+func MergeSort[T constraints.Ordered](data []T, stats *SortStats) {
+	if len(data) < 2 {
+		return
+	}
+	s := sorter[T]{stats}
+	buf := alloc[T](stats, len(data))
+	var sortRange func(lo, hi int)
+	sortRange = func(lo, hi int) {
+		if hi-lo < 2 {
+			return
+		}
+		mid := lo + (hi-lo)/2
+		sortRange(lo, mid)
+		sortRange(mid, hi)
+		s.merge(data, buf, lo, mid, hi)
+	}
+	sortRange(0, len(data))
+}
+
+// MergeSortBottomUp sorts the slice in place using a stable iterative merge sort of doubling run widths
+func MergeSortBottomUp[T constraints.Ordered](data []T, stats *SortStats) {
+	if len(data) < 2 {
+		return
+	}
+	s := sorter[T]{stats}
+	buf := alloc[T](stats, len(data))
+	for width := 1; width < len(data); width *= 2 {
+		for lo := 0; lo < len(data)-width; lo += 2 * width {
+			s.merge(data, buf, lo, lo+width, min(lo+2*width, len(data)))
+		}
+	}
+}
+
+// quickSort drives a partition scheme over data[lo:hi+1]. The partition returns the bounds of the
+// left and right sub ranges still to be sorted, the smaller is recursed so stack depth stays logarithmic
+func (s sorter[T]) quickSort(data []T, lo, hi int, partition func(data []T, lo, hi int) (int, int, int, int)) {
+	for lo < hi {
+		leftLo, leftHi, rightLo, rightHi := partition(data, lo, hi)
+		if leftHi-leftLo < rightHi-rightLo {
+			s.quickSort(data, leftLo, leftHi, partition)
+			lo, hi = rightLo, rightHi
+		} else {
+			s.quickSort(data, rightLo, rightHi, partition)
+			lo, hi = leftLo, leftHi
+		}
+	}
+}
+
+func (s sorter[T]) lomuto(data []T, lo, hi int) (int, int, int, int) {
+	pivot := data[hi]
+	i := lo
+	for j := lo; j < hi; j++ {
+		if s.less(data[j], pivot) {
+			if i != j {
+				s.swap(data, i, j)
+			}
+			i++
+		}
+	}
+	if i != hi {
+		s.swap(data, i, hi)
+	}
+	return lo, i - 1, i + 1, hi
+}
+
+func (s sorter[T]) hoare(data []T, lo, hi int) (int, int, int, int) {
+	pivot := data[lo+(hi-lo)/2]
+	i, j := lo-1, hi+1
+	for {
+		i++
+		for s.less(data[i], pivot) {
+			i++
+		}
+		j--
+		for s.less(pivot, data[j]) {
+			j--
+		}
+		if i >= j {
+			return lo, j, j + 1, hi
+		}
+		s.swap(data, i, j)
+	}
+}
+
+func (s sorter[T]) threeWay(data []T, lo, hi int) (int, int, int, int) {
+	pivot := data[lo]
+	lt, i, gt := lo, lo+1, hi
+	for i <= gt {
+		switch {
+		case s.less(data[i], pivot):
+			s.swap(data, lt, i)
+			lt++
+			i++
+		case s.less(pivot, data[i]):
+			s.swap(data, i, gt)
+			gt--
+		default:
+			i++
+		}
+	}
+	return lo, lt - 1, gt + 1, hi
+}
+
+// QuickSortLomuto sorts the slice in place using quick sort with Lomuto partitioning around the last element
+func QuickSortLomuto[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	s.quickSort(data, 0, len(data)-1, s.lomuto)
+}
+
+// QuickSortHoare sorts the slice in place using quick sort with Hoare partitioning around the middle element
+func QuickSortHoare[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	s.quickSort(data, 0, len(data)-1, s.hoare)
+}
+
+// QuickSort3Way sorts the slice in place using quick sort with Dijkstra's 3-way partitioning,
+// which groups elements equal to the pivot so slices with many duplicates sort efficiently
+func QuickSort3Way[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	s.quickSort(data, 0, len(data)-1, s.threeWay)
+}
+
+// QuickSortRandom sorts the slice in place using quick sort with Hoare partitioning around a
+// randomly chosen pivot, avoiding the quadratic worst case on adversarial input
+func QuickSortRandom[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	s.quickSort(data, 0, len(data)-1, func(data []T, lo, hi int) (int, int, int, int) {
+		pivotIndex := lo + rand.IntN(hi-lo+1)
+		mid := lo + (hi-lo)/2
+		if pivotIndex != mid {
+			s.swap(data, pivotIndex, mid)
+		}
+		return s.hoare(data, lo, hi)
+	})
+}
+
+// siftDown restores the max heap property for the subtree rooted at root within data[:end]
+func (s sorter[T]) siftDown(data []T, root, end int) {
+	for {
+		child := 2*root + 1
+		if child >= end {
+			return
+		}
+		if child+1 < end && s.less(data[child], data[child+1]) {
+			child++
+		}
+		if !s.less(data[root], data[child]) {
+			return
+		}
+		s.swap(data, root, child)
+		root = child
+	}
+}
+
+// HeapSort sorts the slice in place by building a max heap and repeatedly extracting the largest element
+func HeapSort[T constraints.Ordered](data []T, stats *SortStats) {
+	s := sorter[T]{stats}
+	for i := len(data)/2 - 1; i >= 0; i-- {
+		s.siftDown(data, i, len(data))
+	}
+	for end := len(data) - 1; end > 0; end-- {
+		s.swap(data, 0, end)
+		s.siftDown(data, 0, end)
+	}
+}
+
+// maxCountingRange is the largest number of distinct values CountingSort will allocate counts for
+const maxCountingRange = 1 << 24
+
+// CountingSort sorts the slice in place by counting occurrences of each value. It allocates
+// a buffer the size of the range between the smallest and largest values, so suits narrow ranges,
+// and panics if the range spans more than maxCountingRange values
+func CountingSort[T constraints.Integer](data []T, stats *SortStats) {
+	if len(data) < 2 {
+		return
+	}
+	s := sorter[T]{stats}
+	minVal, maxVal := data[0], data[0]
+	for _, val := range data {
+		if val < minVal {
+			minVal = val
+		}
+		if val > maxVal {
+			maxVal = val
+		}
+	}
+	// Offsets are computed in uint64 so the range of small signed types cannot overflow
+	offset := func(val T) int { return int(uint64(val) - uint64(minVal)) }
+	if span := uint64(maxVal) - uint64(minVal); span >= maxCountingRange {
+		panic(fmt.Sprintf("counting sort range %v to %v is too wide, use RadixSort instead", minVal, maxVal))
+	}
+	counts := alloc[int](stats, offset(maxVal)+1)
+	for _, val := range data {
+		counts[offset(val)]++
+	}
+	i := 0
+	for off, count := range counts {
+		for ; count > 0; count-- {
+			s.move(data, i, minVal+T(off))
+			i++
+		}
+	}
+}
+
+// RadixSort sorts the slice in place using a stable least significant digit radix sort over bytes
+func RadixSort[T constraints.Integer](data []T, stats *SortStats) {
+	if len(data) < 2 {
+		return
+	}
+	s := sorter[T]{stats}
+	signed := ^T(0) < 0
+	key := func(val T) uint64 {
+		if signed {
+			// Flip the sign bit so negative values order before positive values
+			return uint64(int64(val)) ^ (1 << 63)
+		}
+		return uint64(val)
+	}
+
+	buf := alloc[T](stats, len(data))
+	src, dst := data, buf
+	for shift := 0; shift < 64; shift += 8 {
+		var counts [257]int
+		for _, val := range src {
+			counts[(key(val)>>shift)&0xff+1]++
+		}
+		if counts[(key(src[0])>>shift)&0xff+1] == len(src) {
+			// Every element shares this digit, the pass would not change the order
+			continue
+		}
+		for d := 1; d < len(counts); d++ {
+			counts[d] += counts[d-1]
+		}
+		for _, val := range src {
+			d := (key(val) >> shift) & 0xff
+			s.move(dst, counts[d], val)
+			counts[d]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &data[0] {
+		copy(data, src)
+	}
+}
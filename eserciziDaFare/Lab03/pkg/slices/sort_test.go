@@ -0,0 +1,110 @@
+package slices
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type sortCase struct {
+	name        string
+	sort        func(data []int, stats *SortStats)
+	compares    bool // the sort is comparison based
+	allocations bool // the sort uses an auxiliary buffer
+}
+
+var sortCases = []sortCase{
+	{"InsertionSort", InsertionSort[int], true, false},
+	{"SelectionSort", SelectionSort[int], true, false},
+	{"BubbleSort", BubbleSort[int], true, false},
+	{"ShellSort", ShellSort[int], true, false},
+	{"MergeSort", MergeSort[int], true, true},
+	{"MergeSortBottomUp", MergeSortBottomUp[int], true, true},
+	{"QuickSortLomuto", QuickSortLomuto[int], true, false},
+	{"QuickSortHoare", QuickSortHoare[int], true, false},
+	{"QuickSort3Way", QuickSort3Way[int], true, false},
+	{"QuickSortRandom", QuickSortRandom[int], true, false},
+	{"HeapSort", HeapSort[int], true, false},
+	{"CountingSort", CountingSort[int], false, true},
+	{"RadixSort", RadixSort[int], false, true},
+}
+
+func TestSortsMatchSortInts(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	inputs := map[string]func() []int{
+		"empty":  func() []int { return []int{} },
+		"single": func() []int { return []int{42} },
+		"random": func() []int {
+			data := make([]int, 500)
+			for i := range data {
+				data[i] = rng.IntN(20000) - 10000
+			}
+			return data
+		},
+		"duplicates": func() []int {
+			data := make([]int, 500)
+			for i := range data {
+				data[i] = rng.IntN(5)
+			}
+			return data
+		},
+		"sorted": func() []int {
+			data := make([]int, 200)
+			for i := range data {
+				data[i] = i
+			}
+			return data
+		},
+	}
+	for _, tc := range sortCases {
+		for inputName, input := range inputs {
+			t.Run(tc.name+"/"+inputName, func(t *testing.T) {
+				data := input()
+				want := append([]int{}, data...)
+				sort.Ints(want)
+				var stats SortStats
+				tc.sort(data, &stats)
+				if !reflect.DeepEqual(data, want) {
+					t.Fatalf("result is not sorted: %v", data)
+				}
+				if len(data) < 2 {
+					if stats != (SortStats{}) {
+						t.Errorf("sorting %d elements recorded %+v", len(data), stats)
+					}
+					return
+				}
+				if tc.compares && stats.Comparisons == 0 {
+					t.Errorf("no comparisons recorded: %+v", stats)
+				}
+				if tc.allocations && stats.Allocations == 0 {
+					t.Errorf("no allocations recorded: %+v", stats)
+				}
+				if inputName != "sorted" && stats.Swaps+stats.Moves == 0 {
+					t.Errorf("no swaps or moves recorded: %+v", stats)
+				}
+			})
+		}
+		tc.sort([]int{3, 1, 2}, nil) //nil stats must be accepted
+	}
+}
+
+func TestCountingSortWideRangePanics(t *testing.T) {
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, "too wide") {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	CountingSort([]int{math.MinInt64, math.MaxInt64}, nil)
+}
+
+func TestCountingSortSmallTypes(t *testing.T) {
+	data := []int8{math.MaxInt8, 0, math.MinInt8, -1, 1}
+	CountingSort(data, nil)
+	if !reflect.DeepEqual(data, []int8{math.MinInt8, -1, 0, 1, math.MaxInt8}) {
+		t.Errorf("CountingSort() = %v", data)
+	}
+}
@@ -0,0 +1,224 @@
+package stats
+
+import (
+	"adventofcode2021/pkg/slices"
+	"constraints"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrEmpty is returned when a statistic is requested for no data
+	ErrEmpty = errors.New("statistic of empty data is not defined")
+	// ErrTooFew is returned when a sample statistic is requested for a single value
+	ErrTooFew = errors.New("sample statistic needs at least 2 values")
+	// ErrLengthMismatch is returned when paired data or weights are not the same length
+	ErrLengthMismatch = errors.New("mismatching data lengths")
+	// ErrZeroWeight is returned when the weights of a weighted mean sum to zero
+	ErrZeroWeight = errors.New("weights sum to zero")
+	// ErrZeroVariance is returned when a correlation is requested for constant data
+	ErrZeroVariance = errors.New("correlation of constant data is not defined")
+	// ErrNoBins is returned when a histogram is requested with fewer than 1 bin
+	ErrNoBins = errors.New("histogram needs at least 1 bin")
+	// ErrNotFinite is returned when a histogram is requested for data containing NaN or infinity
+	ErrNotFinite = errors.New("histogram of NaN or infinite values is not defined")
+)
+
+// Mean returns the arithmetic mean of the data
+func Mean[T slices.Number](data []T) (float64, error) {
+	if len(data) == 0 {
+		return 0, ErrEmpty
+	}
+	var total float64
+	for _, val := range data {
+		total += float64(val)
+	}
+	return total / float64(len(data)), nil
+}
+
+// WeightedMean returns the mean of the data where each value counts in proportion to its weight
+func WeightedMean[T, W slices.Number](data []T, weights []W) (float64, error) {
+	if len(data) != len(weights) {
+		return 0, ErrLengthMismatch
+	}
+	if len(data) == 0 {
+		return 0, ErrEmpty
+	}
+	var total, totalWeight float64
+	for i, val := range data {
+		total += float64(val) * float64(weights[i])
+		totalWeight += float64(weights[i])
+	}
+	if totalWeight == 0 {
+		return 0, ErrZeroWeight
+	}
+	return total / totalWeight, nil
+}
+
+// sumSquaredDeviations returns the sum of squared differences from the mean and the number of values
+func sumSquaredDeviations[T slices.Number](data []T) (float64, error) {
+	mean, err := Mean(data)
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for _, val := range data {
+		d := float64(val) - mean
+		total += d * d
+	}
+	return total, nil
+}
+
+// Variance returns the population variance of the data
+func Variance[T slices.Number](data []T) (float64, error) {
+	ss, err := sumSquaredDeviations(data)
+	if err != nil {
+		return 0, err
+	}
+	return ss / float64(len(data)), nil
+}
+
+// SampleVariance returns the unbiased sample variance of the data, dividing by n-1
+func SampleVariance[T slices.Number](data []T) (float64, error) {
+	if len(data) == 1 {
+		return 0, ErrTooFew
+	}
+	ss, err := sumSquaredDeviations(data)
+	if err != nil {
+		return 0, err
+	}
+	return ss / float64(len(data)-1), nil
+}
+
+// StdDev returns the population standard deviation of the data
+func StdDev[T slices.Number](data []T) (float64, error) {
+	v, err := Variance(data)
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev returns the sample standard deviation of the data, see SampleVariance
+func SampleStdDev[T slices.Number](data []T) (float64, error) {
+	v, err := SampleVariance(data)
+	return math.Sqrt(v), err
+}
+
+// Modes returns every value that occurs the most often, in ascending order
+func Modes[T slices.Number](data []T) ([]T, error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	counts := slices.Frequencies(data)
+	highest := 0
+	for _, count := range counts {
+		highest = max(highest, count)
+	}
+	result := []T{}
+	for val, count := range counts {
+		if count == highest {
+			result = append(result, val)
+		}
+	}
+	slices.InsertionSort(result, nil)
+	return result, nil
+}
+
+// Mode returns the value that occurs the most often, choosing the smallest when there is a tie
+func Mode[T slices.Number](data []T) (T, error) {
+	modes, err := Modes(data)
+	if err != nil {
+		return 0, err
+	}
+	return modes[0], nil
+}
+
+// Bin is a single histogram bin covering [Lo, Hi), the final bin also includes Hi
+type Bin struct {
+	Lo, Hi float64
+	Count  int
+}
+
+// Histogram counts the data into the requested number of equal width bins spanning its minimum to maximum
+func Histogram[T slices.Number](data []T, bins int) ([]Bin, error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	if bins <= 0 {
+		return nil, ErrNoBins
+	}
+	for _, val := range data {
+		if f := float64(val); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, ErrNotFinite
+		}
+	}
+	minVal, maxVal := slices.MinMax(data)
+	lo, hi := float64(minVal), float64(maxVal)
+	width := (hi - lo) / float64(bins)
+
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i] = Bin{Lo: lo + float64(i)*width, Hi: lo + float64(i+1)*width}
+	}
+	result[bins-1].Hi = hi
+	for _, val := range data {
+		i := bins - 1
+		if width > 0 {
+			i = min(int((float64(val)-lo)/width), bins-1)
+		}
+		result[i].Count++
+	}
+	return result, nil
+}
+
+// Covariance returns the population covariance of the paired data
+func Covariance[T, U slices.Number](x []T, y []U) (float64, error) {
+	if len(x) != len(y) {
+		return 0, ErrLengthMismatch
+	}
+	meanX, err := Mean(x)
+	if err != nil {
+		return 0, err
+	}
+	meanY, _ := Mean(y)
+	var total float64
+	for i := range x {
+		total += (float64(x[i]) - meanX) * (float64(y[i]) - meanY)
+	}
+	return total / float64(len(x)), nil
+}
+
+// Correlation returns the Pearson correlation coefficient of the paired data, between -1 and 1
+func Correlation[T, U slices.Number](x []T, y []U) (float64, error) {
+	cov, err := Covariance(x, y)
+	if err != nil {
+		return 0, err
+	}
+	sdX, _ := StdDev(x)
+	sdY, _ := StdDev(y)
+	if sdX == 0 || sdY == 0 {
+		return 0, ErrZeroVariance
+	}
+	return cov / (sdX * sdY), nil
+}
+
+// Triangular returns the n-th triangular number 1 + 2 + ... + n
+func Triangular[T constraints.Integer](n T) T {
+	return n * (n + 1) / 2
+}
+
+// LinearCost returns the total cost of moving every value to target where each step costs 1
+func LinearCost[T constraints.Integer](data []T, target T) T {
+	return slices.SumWeighted(data, func(x T) T { return distance(x, target) })
+}
+
+// TriangularCost returns the total cost of moving every value to target where each step costs
+// one more than the last, so moving a distance d costs Triangular(d)
+func TriangularCost[T constraints.Integer](data []T, target T) T {
+	return slices.SumWeighted(data, func(x T) T { return Triangular(distance(x, target)) })
+}
+
+func distance[T constraints.Integer](a, b T) T {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
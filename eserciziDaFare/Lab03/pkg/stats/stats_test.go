@@ -0,0 +1,137 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+const epsilon = 1e-9
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
+
+func TestMeans(t *testing.T) {
+	if got, err := Mean([]int{16, 1, 2, 0, 4, 2, 7, 1, 2, 14}); err != nil || got != 4.9 {
+		t.Errorf("Mean() = %v, %v, want 4.9", got, err)
+	}
+	if got, err := WeightedMean([]float64{1, 2, 3}, []int{3, 0, 1}); err != nil || got != 1.5 {
+		t.Errorf("WeightedMean() = %v, %v, want 1.5", got, err)
+	}
+	if _, err := WeightedMean([]int{1, 2}, []int{1, -1}); !errors.Is(err, ErrZeroWeight) {
+		t.Errorf("WeightedMean() with zero total weight = %v", err)
+	}
+}
+
+func TestVariance(t *testing.T) {
+	data := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	if got, err := Variance(data); err != nil || got != 4 {
+		t.Errorf("Variance() = %v, %v, want 4", got, err)
+	}
+	if got, err := StdDev(data); err != nil || got != 2 {
+		t.Errorf("StdDev() = %v, %v, want 2", got, err)
+	}
+	if got, err := SampleVariance(data); err != nil || !near(got, 32.0/7) {
+		t.Errorf("SampleVariance() = %v, %v, want 32/7", got, err)
+	}
+	if got, err := SampleStdDev(data); err != nil || !near(got, math.Sqrt(32.0/7)) {
+		t.Errorf("SampleStdDev() = %v, %v", got, err)
+	}
+	if got, err := Variance([]float64{3.5}); err != nil || got != 0 {
+		t.Errorf("Variance() of one value = %v, %v", got, err)
+	}
+}
+
+func TestModes(t *testing.T) {
+	if got, err := Modes([]int{5, 3, 9, 3, 5, 1, 9}); err != nil || !reflect.DeepEqual(got, []int{3, 5, 9}) {
+		t.Errorf("Modes() = %v, %v, want [3 5 9]", got, err)
+	}
+	if got, err := Mode([]int{5, 3, 9, 3, 5, 1, 9}); err != nil || got != 3 {
+		t.Errorf("Mode() = %v, %v, want the smallest tied value 3", got, err)
+	}
+	if got, err := Mode([]float64{-1.5, 2, -1.5}); err != nil || got != -1.5 {
+		t.Errorf("Mode() = %v, %v, want -1.5", got, err)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	got, err := Histogram([]int{0, 1, 2, 2, 5, 9, 10}, 5)
+	want := []Bin{{0, 2, 2}, {2, 4, 2}, {4, 6, 1}, {6, 8, 0}, {8, 10, 2}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram() = %v, %v, want %v", got, err, want)
+	}
+	got, err = Histogram([]float64{7, 7, 7}, 3)
+	if err != nil || got[2].Count != 3 || got[0].Count != 0 {
+		t.Errorf("Histogram() of constant data = %v, %v", got, err)
+	}
+	if _, err := Histogram([]int{1}, 0); !errors.Is(err, ErrNoBins) {
+		t.Errorf("Histogram() with no bins = %v", err)
+	}
+	for _, data := range [][]float64{{1, math.NaN(), 3}, {1, math.Inf(1)}, {math.Inf(-1), 0}} {
+		if _, err := Histogram(data, 4); !errors.Is(err, ErrNotFinite) {
+			t.Errorf("Histogram(%v) = %v, want ErrNotFinite", data, err)
+		}
+	}
+}
+
+func TestCovarianceCorrelation(t *testing.T) {
+	x := []int{1, 2, 3, 4, 5}
+	if got, err := Covariance(x, []float64{2, 4, 6, 8, 10}); err != nil || got != 4 {
+		t.Errorf("Covariance() = %v, %v, want 4", got, err)
+	}
+	if got, err := Correlation(x, []int{10, 8, 6, 4, 2}); err != nil || !near(got, -1) {
+		t.Errorf("Correlation() = %v, %v, want -1", got, err)
+	}
+	if got, err := Correlation(x, []int{1, 3, 2, 5, 4}); err != nil || !near(got, 0.8) {
+		t.Errorf("Correlation() = %v, %v, want 0.8", got, err)
+	}
+	if _, err := Correlation(x, []int{3, 3, 3, 3, 3}); !errors.Is(err, ErrZeroVariance) {
+		t.Errorf("Correlation() of constant data = %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	check := func(name string, err, want error) {
+		t.Helper()
+		if !errors.Is(err, want) {
+			t.Errorf("%s error = %v, want %v", name, err, want)
+		}
+	}
+	_, err := Mean([]int{})
+	check("Mean", err, ErrEmpty)
+	_, err = Variance([]float64{})
+	check("Variance", err, ErrEmpty)
+	_, err = SampleVariance([]int{1})
+	check("SampleVariance", err, ErrTooFew)
+	_, err = SampleStdDev([]int{})
+	check("SampleStdDev", err, ErrEmpty)
+	_, err = Modes([]int{})
+	check("Modes", err, ErrEmpty)
+	_, err = Histogram([]int{}, 3)
+	check("Histogram", err, ErrEmpty)
+	_, err = WeightedMean([]int{1, 2}, []int{1})
+	check("WeightedMean", err, ErrLengthMismatch)
+	_, err = WeightedMean([]int{}, []int{})
+	check("WeightedMean of no data", err, ErrEmpty)
+	_, err = Covariance([]int{1, 2}, []int{1})
+	check("Covariance", err, ErrLengthMismatch)
+	_, err = Covariance([]int{}, []int{})
+	check("Covariance of no data", err, ErrEmpty)
+	_, err = Correlation([]int{1}, []int{1, 2})
+	check("Correlation", err, ErrLengthMismatch)
+}
+
+func TestCrabCosts(t *testing.T) {
+	crabs := []int{16, 1, 2, 0, 4, 2, 7, 1, 2, 14}
+	if got := LinearCost(crabs, 2); got != 37 {
+		t.Errorf("LinearCost() = %d, want 37", got)
+	}
+	if got := TriangularCost(crabs, 5); got != 168 {
+		t.Errorf("TriangularCost() = %d, want 168", got)
+	}
+	if got := Triangular(uint8(11)); got != 66 {
+		t.Errorf("Triangular(11) = %d, want 66", got)
+	}
+}
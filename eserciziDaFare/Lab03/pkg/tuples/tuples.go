@@ -1,10 +1,79 @@
 package tuples
 
-import (
-	"adventofcode2021/pkg/convert"
-)
+import "constraints"
 
-type Pair[T, U convert.Convertable] struct {
+type Pair[T, U any] struct {
 	Key   T
 	Value U
 }
+
+type Triple[T, U, V any] struct {
+	First  T
+	Second U
+	Third  V
+}
+
+// NewPair creates a pair from its components
+func NewPair[T, U any](key T, value U) Pair[T, U] {
+	return Pair[T, U]{Key: key, Value: value}
+}
+
+// Unpack returns the components of the pair
+func (p Pair[T, U]) Unpack() (T, U) {
+	return p.Key, p.Value
+}
+
+// Swap returns a new pair with the key and value exchanged
+func (p Pair[T, U]) Swap() Pair[U, T] {
+	return Pair[U, T]{Key: p.Value, Value: p.Key}
+}
+
+// NewTriple creates a triple from its components
+func NewTriple[T, U, V any](first T, second U, third V) Triple[T, U, V] {
+	return Triple[T, U, V]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns the components of the triple
+func (t Triple[T, U, V]) Unpack() (T, U, V) {
+	return t.First, t.Second, t.Third
+}
+
+// ComparePair orders pairs by key then by value, returning -1, 0 or 1
+func ComparePair[T, U constraints.Ordered](a, b Pair[T, U]) int {
+	if c := compare(a.Key, b.Key); c != 0 {
+		return c
+	}
+	return compare(a.Value, b.Value)
+}
+
+// LessPair returns true if a is ordered before b, comparing by key then by value
+func LessPair[T, U constraints.Ordered](a, b Pair[T, U]) bool {
+	return ComparePair(a, b) < 0
+}
+
+// CompareTriple orders triples component by component, returning -1, 0 or 1
+func CompareTriple[T, U, V constraints.Ordered](a, b Triple[T, U, V]) int {
+	if c := compare(a.First, b.First); c != 0 {
+		return c
+	}
+	if c := compare(a.Second, b.Second); c != 0 {
+		return c
+	}
+	return compare(a.Third, b.Third)
+}
+
+// LessTriple returns true if a is ordered before b, comparing component by component
+func LessTriple[T, U, V constraints.Ordered](a, b Triple[T, U, V]) bool {
+	return CompareTriple(a, b) < 0
+}
+
+func compare[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
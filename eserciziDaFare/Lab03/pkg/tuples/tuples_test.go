@@ -0,0 +1,42 @@
+package tuples
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPairJSONRoundTrip(t *testing.T) {
+	p := NewPair("forward", 5)
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"Key":"forward","Value":5}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+	var decoded Pair[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != p {
+		t.Errorf("JSON round trip = %+v, want %+v", decoded, p)
+	}
+}
+
+func TestPairGobRoundTrip(t *testing.T) {
+	pairs := []Pair[int, []string]{NewPair(1, []string{"a", "b"}), NewPair(-2, []string{"c"})}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(pairs); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []Pair[int, []string]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, pairs) {
+		t.Errorf("gob round trip = %+v, want %+v", decoded, pairs)
+	}
+}